@@ -220,38 +220,10 @@ func handleGetUser(w http.ResponseWriter, r *http.Request, id int) {
 }
 
 func handleCreateUser(w http.ResponseWriter, r *http.Request) {
-	// Read request body
-	var body []byte
-	if r.Body != nil {
-		defer r.Body.Close()
-		buf := make([]byte, 1024)
-		for {
-			n, err := r.Body.Read(buf)
-			if n > 0 {
-				body = append(body, buf[:n]...)
-			}
-			if err != nil {
-				break
-			}
-		}
-	}
-
-	// Parse and validate request using gopantic
-	createReq, err := model.ParseInto[CreateUserRequest](body)
-	if err != nil {
-		// Convert gopantic errors to structured API response
-		if errorList, ok := err.(model.ErrorList); ok {
-			// Serialize validation errors to structured format
-			if jsonData, jsonErr := errorList.ToJSON(); jsonErr == nil {
-				var errorReport model.StructuredErrorReport
-				if parseErr := json.Unmarshal(jsonData, &errorReport); parseErr == nil {
-					writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", errorReport)
-					return
-				}
-			}
-		}
-
-		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error(), nil)
+	// Read, parse, and validate the request body using gopantic
+	createReq, errs := model.BindRequest[CreateUserRequest](r)
+	if errs != nil {
+		writeError(w, errs.HTTPStatus(), "VALIDATION_ERROR", "Request validation failed", errs.ToStructuredReportWithParseErrors())
 		return
 	}
 
@@ -304,37 +276,10 @@ func handleUpdateUser(w http.ResponseWriter, r *http.Request, id int) {
 		return
 	}
 
-	// Read request body
-	var body []byte
-	if r.Body != nil {
-		defer r.Body.Close()
-		buf := make([]byte, 1024)
-		for {
-			n, err := r.Body.Read(buf)
-			if n > 0 {
-				body = append(body, buf[:n]...)
-			}
-			if err != nil {
-				break
-			}
-		}
-	}
-
-	// Parse and validate update request using gopantic
-	updateReq, err := model.ParseInto[UpdateUserRequest](body)
-	if err != nil {
-		// Convert gopantic errors to structured API response
-		if errorList, ok := err.(model.ErrorList); ok {
-			if jsonData, jsonErr := errorList.ToJSON(); jsonErr == nil {
-				var errorReport model.StructuredErrorReport
-				if parseErr := json.Unmarshal(jsonData, &errorReport); parseErr == nil {
-					writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Request validation failed", errorReport)
-					return
-				}
-			}
-		}
-
-		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error(), nil)
+	// Read, parse, and validate the update request body using gopantic
+	updateReq, errs := model.BindRequest[UpdateUserRequest](r)
+	if errs != nil {
+		writeError(w, errs.HTTPStatus(), "VALIDATION_ERROR", "Request validation failed", errs.ToStructuredReportWithParseErrors())
 		return
 	}
 
@@ -18,10 +18,10 @@ type APIResponse struct {
 
 // Config represents application configuration that might be parsed repeatedly
 type Config struct {
-	Host    string `json:"host"`
-	Port    int    `json:"port"`
-	Timeout int    `json:"timeout_ms"`
-	Enabled bool   `json:"enabled"`
+	Host    string        `json:"host"`
+	Port    int           `json:"port"`
+	Timeout time.Duration `json:"timeout"`
+	Enabled bool          `json:"enabled"`
 }
 
 func main() {
@@ -33,7 +33,7 @@ func main() {
 	parser := model.NewCachedParser[Config](nil) // Uses default config
 	defer parser.Close()                         // Clean up background goroutine
 
-	configJSON := []byte(`{"host": "localhost", "port": "8080", "timeout_ms": "5000", "enabled": "true"}`)
+	configJSON := []byte(`{"host": "localhost", "port": "8080", "timeout": "5s", "enabled": "true"}`)
 
 	// First parse - cache miss
 	fmt.Println("   First parse (cache miss):")
@@ -111,7 +111,7 @@ func main() {
 	staticConfigJSON := []byte(`{
 		"host": "api.example.com",
 		"port": 443,
-		"timeout_ms": 30000,
+		"timeout": "30s",
 		"enabled": true
 	}`)
 
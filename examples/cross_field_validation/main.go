@@ -28,10 +28,10 @@ type AccountSettings struct {
 	ConfirmPassword   string `json:"confirm_password,omitempty" validate:"new_password_match"`
 }
 
-// PriceRange demonstrates numeric cross-field validation
+// PriceRange demonstrates the built-in gtfield numeric cross-field validator
 type PriceRange struct {
 	MinPrice float64 `json:"min_price" validate:"required,min=0"`
-	MaxPrice float64 `json:"max_price" validate:"required,min=0,max_greater_than_min"`
+	MaxPrice float64 `json:"max_price" validate:"required,min=0,gtfield=MinPrice"`
 }
 
 func init() {
@@ -150,27 +150,6 @@ func init() {
 
 		return nil
 	})
-
-	// Register numeric comparison validator
-	model.RegisterGlobalCrossFieldFunc("max_greater_than_min", func(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
-		maxPrice, ok := fieldValue.(float64)
-		if !ok {
-			return model.NewValidationError(fieldName, fieldValue, "max_greater_than_min", "max price must be a number")
-		}
-
-		minPriceField := structValue.FieldByName("MinPrice")
-		if !minPriceField.IsValid() {
-			return model.NewValidationError(fieldName, fieldValue, "max_greater_than_min", "min price field not found")
-		}
-
-		minPrice := minPriceField.Float()
-		if maxPrice <= minPrice {
-			return model.NewValidationError(fieldName, fieldValue, "max_greater_than_min",
-				fmt.Sprintf("max price (%.2f) must be greater than min price (%.2f)", maxPrice, minPrice))
-		}
-
-		return nil
-	})
 }
 
 func main() {
@@ -9,7 +9,7 @@ import (
 // DatabaseConfig demonstrates nested YAML configuration
 type DatabaseConfig struct {
 	Host     string `yaml:"host" validate:"required"`
-	Port     int    `yaml:"port" validate:"min=1,max=65535"`
+	Port     int    `yaml:"port" validate:"port"`
 	Username string `yaml:"username" validate:"required"`
 	Password string `yaml:"password" validate:"required"`
 	Database string `yaml:"database" validate:"required"`
@@ -0,0 +1,189 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseAll parses each input independently with ParseInto. The returned slices are
+// parallel to inputs: results[i] holds the parsed value and errs[i] is nil on success,
+// or holds the error and leaves results[i] as the zero value.
+//
+// Example:
+//
+//	users, errs := model.ParseAll[User](payloads)
+//	for i, err := range errs {
+//	    if err != nil {
+//	        log.Printf("payload %d: %v", i, err)
+//	    }
+//	}
+func ParseAll[T any](inputs [][]byte) ([]T, []error) {
+	results := make([]T, len(inputs))
+	errs := make([]error, len(inputs))
+
+	for i, input := range inputs {
+		results[i], errs[i] = ParseInto[T](input)
+	}
+
+	return results, errs
+}
+
+// ParseAllConcurrent is like ParseAll but parses inputs using up to workers goroutines.
+// Result order always matches input order. A workers value below 1 is treated as 1.
+func ParseAllConcurrent[T any](inputs [][]byte, workers int) ([]T, []error) {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+	if workers <= 1 {
+		return ParseAll[T](inputs)
+	}
+
+	results := make([]T, len(inputs))
+	errs := make([]error, len(inputs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = ParseInto[T](inputs[i])
+			}
+		}()
+	}
+
+	for i := range inputs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results, errs
+}
+
+// ParseAllYAML parses a YAML stream containing one or more "---" separated documents,
+// such as a Kubernetes manifest, parsing and validating each document into T.
+// Empty documents are skipped and do not count towards document indexes. Every
+// document is parsed even if an earlier one fails; failures are returned together as an
+// ErrorList whose entries are prefixed with the zero-based document index, and the
+// failed documents are left as zero values.
+//
+// Example:
+//
+//	services, err := model.ParseAllYAML[Service](manifest)
+//	if err != nil {
+//	    log.Fatal(err) // e.g. `document 1: validation error on field "Port": ...`
+//	}
+func ParseAllYAML[T any](data []byte) ([]T, error) {
+	if err := checkInputSize(len(data)); err != nil {
+		return nil, err
+	}
+
+	var results []T
+	var errs ErrorList
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		// index matches the position in results, so empty documents are not counted
+		index := len(results)
+
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			// A syntax error leaves the decoder unusable, so stop here
+			errs.Add(fmt.Errorf("document %d: yaml parse error: %w", index, err))
+			break
+		}
+		if isEmptyYAMLDocument(&doc) {
+			continue
+		}
+
+		raw, err := yaml.Marshal(&doc)
+		if err != nil {
+			errs.Add(fmt.Errorf("document %d: %w", index, err))
+			break
+		}
+
+		result, err := ParseIntoWithFormat[T](raw, FormatYAML)
+		if err != nil {
+			errs.Add(fmt.Errorf("document %d: %w", index, err))
+		}
+		results = append(results, result)
+	}
+
+	return results, errs.AsError()
+}
+
+// isEmptyYAMLDocument reports whether a decoded document has no content or only a null
+// value, as produced by consecutive "---" markers
+func isEmptyYAMLDocument(doc *yaml.Node) bool {
+	if len(doc.Content) == 0 {
+		return true
+	}
+	node := doc.Content[0]
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+// ParseNDJSON reads newline-delimited JSON from r, parsing and validating each line into T
+// and passing the result to fn. Blank lines are skipped. A line that fails to parse is
+// reported to fn with a zero T and an error prefixed with its 1-based line number, and
+// reading continues. Lines longer than MaxInputSize (when set) stop reading.
+//
+// The returned error is non-nil only if reading from r fails.
+//
+// Example:
+//
+//	err := model.ParseNDJSON(file, func(event Event, err error) {
+//	    if err != nil {
+//	        log.Printf("skipping: %v", err) // e.g. "line 3: validation error ..."
+//	        return
+//	    }
+//	    process(event)
+//	})
+func ParseNDJSON[T any](r io.Reader, fn func(T, error)) error {
+	// Each line may be as long as MaxInputSize; longer lines stop the scanner
+	maxLine := math.MaxInt32
+	if maxSize := GetMaxInputSize(); maxSize > 0 {
+		maxLine = maxSize
+	}
+	// The buffer also holds the newline that ends a line
+	bufSize := maxLine
+	if bufSize < math.MaxInt {
+		bufSize++
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(64*1024, bufSize)), bufSize)
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		result, err := ParseIntoWithFormat[T](line, FormatJSON)
+		if err != nil {
+			var zero T
+			fn(zero, fmt.Errorf("line %d: %w", lineNum, err))
+			continue
+		}
+		fn(result, nil)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("ndjson read error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	bigIntType      = reflect.TypeOf(big.Int{})
+	bigIntPtrType   = reflect.TypeOf(&big.Int{})
+	bigFloatType    = reflect.TypeOf(big.Float{})
+	bigFloatPtrType = reflect.TypeOf(&big.Float{})
+)
+
+// coerceToBigNumber handles big.Int and big.Float targets (by value or pointer).
+// It reports false for any other target type.
+func coerceToBigNumber(value interface{}, targetType reflect.Type, fieldName string) (interface{}, bool, error) {
+	switch targetType {
+	case bigIntPtrType:
+		n, err := coerceToBigInt(value, fieldName)
+		return n, true, err
+	case bigIntType:
+		n, err := coerceToBigInt(value, fieldName)
+		if err != nil {
+			return nil, true, err
+		}
+		return *n, true, nil
+	case bigFloatPtrType:
+		f, err := coerceToBigFloat(value, fieldName)
+		return f, true, err
+	case bigFloatType:
+		f, err := coerceToBigFloat(value, fieldName)
+		if err != nil {
+			return nil, true, err
+		}
+		return *f, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// coerceToBigInt converts strings and numbers to *big.Int without range limits.
+// Strings keep full precision; floats must be whole numbers.
+func coerceToBigInt(value interface{}, fieldName string) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	case string:
+		n, ok := new(big.Int).SetString(strings.TrimSpace(v), 10)
+		if !ok {
+			return nil, NewParseError(fieldName, v, "big.Int",
+				fmt.Sprintf("cannot parse string %q as integer", v))
+		}
+		return n, nil
+	case json.Number:
+		if n, ok := new(big.Int).SetString(v.String(), 10); ok {
+			return n, nil
+		}
+		// Exponent forms such as 1e30 are accepted when they denote a whole number
+		f, err := parseBigFloat(v.String())
+		if err != nil || !f.IsInt() {
+			return nil, NewParseError(fieldName, v, "big.Int",
+				fmt.Sprintf("cannot convert %s to integer", v))
+		}
+		if err := checkBigExponent(f, v, "big.Int", fieldName); err != nil {
+			return nil, err
+		}
+		n, _ := f.Int(nil)
+		return n, nil
+	case float32, float64:
+		f := reflect.ValueOf(v).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+			return nil, NewParseError(fieldName, v, "big.Int",
+				fmt.Sprintf("cannot convert %g to integer", f))
+		}
+		n, _ := big.NewFloat(f).Int(nil)
+		return n, nil
+	case int, int8, int16, int32, int64:
+		return big.NewInt(reflect.ValueOf(v).Int()), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Int).SetUint64(reflect.ValueOf(v).Uint()), nil
+	default:
+		return nil, NewParseError(fieldName, value, "big.Int",
+			fmt.Sprintf("cannot coerce %T to big.Int", value))
+	}
+}
+
+// coerceToBigFloat converts strings and numbers to *big.Float. String input is parsed
+// with enough precision to represent every digit it contains.
+func coerceToBigFloat(value interface{}, fieldName string) (*big.Float, error) {
+	switch v := value.(type) {
+	case *big.Float:
+		return new(big.Float).Copy(v), nil
+	case string:
+		f, err := parseBigFloat(strings.TrimSpace(v))
+		if err != nil {
+			return nil, NewParseError(fieldName, v, "big.Float",
+				fmt.Sprintf("cannot parse string %q as number", v))
+		}
+		if err := checkBigExponent(f, v, "big.Float", fieldName); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case json.Number:
+		f, err := parseBigFloat(v.String())
+		if err != nil {
+			return nil, NewParseError(fieldName, v, "big.Float",
+				fmt.Sprintf("cannot parse number %s", v))
+		}
+		if err := checkBigExponent(f, v, "big.Float", fieldName); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case float32, float64:
+		f := reflect.ValueOf(v).Float()
+		if math.IsNaN(f) {
+			return nil, NewParseError(fieldName, v, "big.Float", "NaN cannot be represented as big.Float")
+		}
+		return big.NewFloat(f), nil
+	case int, int8, int16, int32, int64:
+		return new(big.Float).SetInt64(reflect.ValueOf(v).Int()), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Float).SetUint64(reflect.ValueOf(v).Uint()), nil
+	default:
+		return nil, NewParseError(fieldName, value, "big.Float",
+			fmt.Sprintf("cannot coerce %T to big.Float", value))
+	}
+}
+
+// parseBigFloat parses a decimal number with enough precision for every digit it contains
+func parseBigFloat(s string) (*big.Float, error) {
+	f, _, err := big.ParseFloat(s, 10, bigFloatPrecision(s), big.ToNearestEven)
+	return f, err
+}
+
+// defaultBigNumberDigits bounds big numbers when MaxInputSize is disabled
+const defaultBigNumberDigits = 10 * 1024 * 1024
+
+// checkBigExponent rejects a number in exponent form, such as 1e50000000, whose integer
+// or fractional part would need more decimal digits than MaxInputSize lets input spell
+// out. A few bytes of input would otherwise expand into an arbitrarily large big.Int.
+func checkBigExponent(f *big.Float, value interface{}, targetType, fieldName string) error {
+	maxDigits := GetMaxInputSize()
+	if maxDigits <= 0 {
+		maxDigits = defaultBigNumberDigits
+	}
+
+	// A binary exponent of e spans about e*log10(2) decimal digits
+	exp := f.MantExp(nil)
+	if exp < 0 {
+		exp = -exp
+	}
+	if float64(exp)*math.Log10(2) > float64(maxDigits) {
+		return NewParseError(fieldName, value, targetType,
+			fmt.Sprintf("number %v exceeds the maximum of %d digits", value, maxDigits))
+	}
+	return nil
+}
+
+// bigFloatPrecision returns a mantissa precision in bits large enough for the decimal
+// digits in s (about 3.33 bits per digit), and never less than big.Float's default of 64.
+func bigFloatPrecision(s string) uint {
+	prec := uint(len(s)) * 4
+	if prec < 64 {
+		prec = 64
+	}
+	return prec
+}
+
+// compareBigNumber compares a big.Int or big.Float value (or pointer) against limit.
+// It reports false when value is not a big number or is a nil pointer.
+func compareBigNumber(value interface{}, limit float64) (int, bool) {
+	bound := big.NewFloat(limit)
+
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return 0, false
+		}
+		return new(big.Float).SetInt(v).Cmp(bound), true
+	case big.Int:
+		return new(big.Float).SetInt(&v).Cmp(bound), true
+	case *big.Float:
+		if v == nil {
+			return 0, false
+		}
+		return v.Cmp(bound), true
+	case big.Float:
+		return v.Cmp(bound), true
+	default:
+		return 0, false
+	}
+}
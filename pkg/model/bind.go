@@ -0,0 +1,170 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BindRequest reads the body of r and parses and validates it into T, returning the
+// failures as an ErrorList ready for ToStructuredReportWithParseErrors, which also reports
+// malformed bodies.
+//
+// The format follows the Content-Type header: JSON (application/json or any +json type),
+// YAML (application/yaml, application/x-yaml, text/yaml or any +yaml type), or URL-encoded
+// forms, which are parsed as by ParseForm. Without a Content-Type the format is detected
+// from the body; other types are rejected with a 415 Unsupported Media Type HTTPStatus.
+// Bodies larger than MaxInputSize are rejected without being read in full, with a 413
+// Payload Too Large HTTPStatus. r's context is passed to context-aware validators.
+//
+// Example:
+//
+//	func createUser(w http.ResponseWriter, r *http.Request) {
+//	    req, errs := model.BindRequest[CreateUserRequest](r)
+//	    if errs != nil {
+//	        body, _ := json.Marshal(errs.ToStructuredReportWithParseErrors())
+//	        w.Header().Set("Content-Type", "application/json")
+//	        w.WriteHeader(errs.HTTPStatus())
+//	        w.Write(body)
+//	        return
+//	    }
+//	    // use req
+//	}
+func BindRequest[T any](r *http.Request) (T, *ErrorList) {
+	var zero T
+
+	body, err := readRequestBody(r)
+	if err != nil {
+		return zero, asErrorList(err)
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return bindResult(parseIntoWithFormatContext[T](r.Context(), body, DetectFormat(body)))
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return zero, asErrorList(NewParseError("", contentType, "", fmt.Sprintf("invalid Content-Type: %v", err)))
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return bindResult(parseIntoWithFormatContext[T](r.Context(), body, FormatJSON))
+	case mediaType == "application/yaml" || mediaType == "application/x-yaml" ||
+		mediaType == "text/yaml" || mediaType == "text/x-yaml" || strings.HasSuffix(mediaType, "+yaml"):
+		return bindResult(parseIntoWithFormatContext[T](r.Context(), body, FormatYAML))
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return zero, asErrorList(NewParseError("", nil, "", fmt.Sprintf("invalid form body: %v", err)))
+		}
+		return bindResult(parseFormContext[T](r.Context(), values))
+	default:
+		return zero, asErrorList(&requestError{
+			ParseError: NewParseError("", mediaType, "", fmt.Sprintf("unsupported Content-Type %q", mediaType)),
+			status:     http.StatusUnsupportedMediaType,
+		})
+	}
+}
+
+// BindHandler adapts a handler that takes a parsed request to an http.HandlerFunc. The
+// body is bound with BindRequest; on failure the handler is not called and the client
+// receives ToStructuredReportWithParseErrors as JSON with the ErrorList's HTTPStatus:
+// 415 for an unsupported Content-Type, 413 for an oversized body, and otherwise 400 Bad
+// Request unless SetRuleHTTPStatus maps the failed rules elsewhere.
+//
+// Example:
+//
+//	http.Handle("/users", model.BindHandler(func(w http.ResponseWriter, r *http.Request, req CreateUserRequest) {
+//	    // req is parsed and valid
+//	}))
+func BindHandler[T any](handle func(w http.ResponseWriter, r *http.Request, req T)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, errs := BindRequest[T](r)
+		if errs != nil {
+			writeErrorReport(w, *errs)
+			return
+		}
+		handle(w, r, req)
+	}
+}
+
+// readRequestBody reads r's body, reading at most one byte past MaxInputSize so oversized
+// bodies are rejected without buffering them
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	defer r.Body.Close()
+
+	reader := io.Reader(r.Body)
+	maxSize := GetMaxInputSize()
+	if maxSize > 0 {
+		reader = io.LimitReader(r.Body, int64(maxSize)+1)
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, NewParseError("", nil, "", fmt.Sprintf("cannot read request body: %v", err))
+	}
+	if maxSize > 0 && len(body) > maxSize {
+		return nil, &requestError{
+			ParseError: NewParseError("", nil, "",
+				fmt.Sprintf("request body exceeds maximum allowed size %d bytes", maxSize)),
+			status: http.StatusRequestEntityTooLarge,
+		}
+	}
+	return body, nil
+}
+
+// requestError is a ParseError for a request rejected before its body is parsed, such
+// as an unsupported Content-Type or an oversized body, with the HTTP status that
+// describes the rejection for ErrorList.HTTPStatus
+type requestError struct {
+	*ParseError
+	status int
+}
+
+// Unwrap returns the ParseError, so errors.As still finds it
+func (e *requestError) Unwrap() error {
+	return e.ParseError
+}
+
+// HTTPStatus returns the status for responding to the rejected request
+func (e *requestError) HTTPStatus() int {
+	return e.status
+}
+
+// bindResult converts a parse result to BindRequest's return values
+func bindResult[T any](result T, err error) (T, *ErrorList) {
+	if err != nil {
+		var zero T
+		return zero, asErrorList(err)
+	}
+	return result, nil
+}
+
+// asErrorList returns err as an ErrorList, wrapping single errors in a one-entry list
+func asErrorList(err error) *ErrorList {
+	var errs ErrorList
+	errs.Add(err)
+	return &errs
+}
+
+// writeErrorReport writes errs as a JSON response with the status from errs.HTTPStatus
+func writeErrorReport(w http.ResponseWriter, errs ErrorList) {
+	status := errs.HTTPStatus()
+	body, err := json.Marshal(errs.ToStructuredReportWithParseErrors())
+	if err != nil {
+		http.Error(w, errs.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"hash/fnv"
 	"reflect"
@@ -16,6 +17,39 @@ type CacheConfig struct {
 	TTL             time.Duration // Time to live for cached entries (default: 1 hour)
 	MaxEntries      int           // Maximum number of cached entries (default: 1000)
 	CleanupInterval time.Duration // How often to run cleanup (default: TTL/2, 0 to disable)
+	Backend         CacheBackend  // Optional shared store such as Redis; nil uses the in-memory cache
+}
+
+// CacheBackend is an external store for CachedParser results, letting several service
+// instances share one cache. Values are parsed results encoded as JSON; the backend is
+// responsible for expiring them after ttl. Implementations must be safe for concurrent use.
+//
+// Example (Redis via go-redis):
+//
+//	type redisBackend struct{ client *redis.Client }
+//
+//	func (b redisBackend) Get(key string) ([]byte, bool, error) {
+//	    data, err := b.client.Get(context.Background(), key).Bytes()
+//	    if errors.Is(err, redis.Nil) {
+//	        return nil, false, nil
+//	    }
+//	    return data, err == nil, err
+//	}
+//
+//	func (b redisBackend) Set(key string, value []byte, ttl time.Duration) error {
+//	    return b.client.Set(context.Background(), key, value, ttl).Err()
+//	}
+//
+//	func (b redisBackend) Delete(key string) error {
+//	    return b.client.Del(context.Background(), key).Err()
+//	}
+type CacheBackend interface {
+	// Get returns the stored value and true, or false if key is absent or expired
+	Get(key string) ([]byte, bool, error)
+	// Set stores value under key for ttl
+	Set(key string, value []byte, ttl time.Duration) error
+	// Delete removes key; deleting an absent key is not an error
+	Delete(key string) error
 }
 
 // DefaultCacheConfig returns sensible defaults for in-memory caching
@@ -47,6 +81,10 @@ type CachedParser[T any] struct {
 // NewCachedParser creates a new cached parser with optional configuration.
 // If CleanupInterval > 0, a background goroutine will periodically clean expired entries.
 // Call Close() when done to stop the cleanup goroutine.
+//
+// When config.Backend is set, results are stored there as JSON instead of in memory, and
+// MaxEntries and CleanupInterval do not apply. Backend errors are treated as cache misses,
+// so an unavailable cache never makes parsing fail.
 func NewCachedParser[T any](config *CacheConfig) *CachedParser[T] {
 	if config == nil {
 		config = DefaultCacheConfig()
@@ -62,8 +100,8 @@ func NewCachedParser[T any](config *CacheConfig) *CachedParser[T] {
 		stopCleanup: make(chan struct{}),
 	}
 
-	// Start cleanup goroutine if interval is configured
-	if config.CleanupInterval > 0 {
+	// Start cleanup goroutine if interval is configured; a backend expires its own entries
+	if config.CleanupInterval > 0 && config.Backend == nil {
 		go cp.cleanupLoop()
 	}
 
@@ -97,6 +135,10 @@ func (cp *CachedParser[T]) ParseWithFormat(data []byte, format Format) (T, error
 
 // get retrieves a value from cache with TTL check
 func (cp *CachedParser[T]) get(key string) (T, bool) {
+	if cp.config.Backend != nil {
+		return cp.getFromBackend(key)
+	}
+
 	cp.mu.RLock()
 	entry, exists := cp.cache[key]
 	cp.mu.RUnlock()
@@ -134,6 +176,11 @@ func (cp *CachedParser[T]) get(key string) (T, bool) {
 
 // set stores a value in cache with size limit enforcement
 func (cp *CachedParser[T]) set(key string, value T) {
+	if cp.config.Backend != nil {
+		cp.setInBackend(key, value)
+		return
+	}
+
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
 
@@ -148,6 +195,38 @@ func (cp *CachedParser[T]) set(key string, value T) {
 	}
 }
 
+// getFromBackend retrieves and decodes a value from the configured backend.
+// Entries that no longer decode into T are deleted.
+func (cp *CachedParser[T]) getFromBackend(key string) (T, bool) {
+	var result T
+
+	data, found, err := cp.config.Backend.Get(key)
+	if err != nil || !found {
+		atomic.AddUint64(&cp.misses, 1)
+		return result, false
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		_ = cp.config.Backend.Delete(key) // best effort; the entry is re-parsed either way
+		atomic.AddUint64(&cp.misses, 1)
+		var zero T
+		return zero, false
+	}
+
+	atomic.AddUint64(&cp.hits, 1)
+	return result, true
+}
+
+// setInBackend encodes a value as JSON and stores it in the configured backend.
+// Failures are ignored because the value has already been parsed successfully.
+func (cp *CachedParser[T]) setInBackend(key string, value T) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	_ = cp.config.Backend.Set(key, data, cp.config.TTL)
+}
+
 // evictOldest removes the oldest entry from cache
 func (cp *CachedParser[T]) evictOldest() {
 	var oldestKey string
@@ -188,7 +267,8 @@ func (cp *CachedParser[T]) generateCacheKey(data []byte, format Format) string {
 	return fmt.Sprintf("%s:%s:%v", contentHash, cp.keyPrefix, format)
 }
 
-// ClearCache removes all cached entries
+// ClearCache removes all in-memory cached entries. Entries in a configured Backend are
+// left to expire, since the backend interface has no way to list them.
 func (cp *CachedParser[T]) ClearCache() {
 	cp.mu.Lock()
 	defer cp.mu.Unlock()
@@ -232,7 +312,8 @@ func (cp *CachedParser[T]) cleanupExpired() {
 	}
 }
 
-// Stats returns cache statistics including size, max size, and hit rate
+// Stats returns cache statistics including size, max size, and hit rate.
+// With a Backend, size counts only in-memory entries and is therefore 0.
 func (cp *CachedParser[T]) Stats() (size, maxSize int, hitRate float64) {
 	cp.mu.RLock()
 	size = len(cp.cache)
@@ -1,11 +1,38 @@
 package model
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// durationType is the reflect.Type of time.Duration, which is coerced from strings like "30s"
+	durationType = reflect.TypeOf(time.Duration(0))
+	// jsonNumberType is the reflect.Type of json.Number, which JSON numbers decode to
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+
+	// jsonUnmarshalerType is the reflect.Type of the json.Unmarshaler interface
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	// yamlUnmarshalerType is the reflect.Type of the yaml.Unmarshaler interface
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	// textUnmarshalerType is the reflect.Type of the encoding.TextUnmarshaler interface
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	// scannerType is the reflect.Type of the sql.Scanner interface
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
 )
 
 // CoerceValue attempts to coerce a value to the target type with intelligent type conversion.
@@ -28,17 +55,63 @@ func CoerceValue(value interface{}, targetType reflect.Type, fieldName string) (
 // - String <-> numeric types (int, float, etc.)
 // - String <-> bool (true/false, 1/0, yes/no, etc.)
 // - String/numeric -> time.Time (various formats)
+// - String/numeric -> time.Duration ("30s" or nanoseconds)
+// - String/numeric -> big.Int, big.Float (and pointers) without range limits
 // - Array/slice element coercion
 // - Map -> struct conversion with nested coercion
+// - Map -> map conversion with key and value coercion
+//
+// Types implementing json.Unmarshaler (or yaml.Unmarshaler under FormatYAML) are
+// decoded with their own method instead of the built-in rules. String values are
+// passed to encoding.TextUnmarshaler implementations such as net.IP.
+//
+// Values nested deeper than MaxStructureDepth, or holding arrays longer than MaxArrayLength,
+// are rejected with a *ParseError before any coercion, so untrusted decoded data cannot drive
+// unbounded recursion or allocation.
 func CoerceValueWithFormat(value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
+	if err := checkStructureLimits(value); err != nil {
+		return nil, prefixFieldPaths(err, fieldName)
+	}
+	return coerceValueContext(context.Background(), value, targetType, fieldName, format)
+}
+
+// coerceValueContext is CoerceValueWithFormat with a context that is passed on to
+// context-aware validators of nested structs
+func coerceValueContext(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
+	if s, ok := value.(string); ok && isNullString(s) {
+		value = nil
+	}
 	if value == nil {
 		return getZeroValueForType(targetType), nil
 	}
 
+	// Registered coercers take precedence over every built-in rule
+	if result, ok, err := coerceWithRegistered(value, targetType, fieldName); ok {
+		return result, err
+	}
+
+	if err := checkExactType(ctx, value, targetType, fieldName); err != nil {
+		return nil, err
+	}
+
 	// Handle specific struct types first
 	if targetType == reflect.TypeOf(time.Time{}) {
 		return coerceToTime(value, fieldName)
 	}
+	if targetType == durationType {
+		return coerceToDuration(value, fieldName)
+	}
+	if result, ok, err := coerceToBigNumber(value, targetType, fieldName); ok {
+		return result, err
+	}
+
+	// Custom types decode themselves (enums, money types, json.RawMessage, net.IP, ...)
+	if result, ok, err := coerceWithUnmarshaler(value, targetType, fieldName, format); ok {
+		return result, err
+	}
+	if result, ok, err := coerceWithScanner(value, targetType, fieldName); ok {
+		return result, err
+	}
 
 	// Fall back to kind-based coercion
 	targetKind := targetType.Kind()
@@ -46,32 +119,256 @@ func CoerceValueWithFormat(value interface{}, targetType reflect.Type, fieldName
 	case reflect.String:
 		return coerceToString(value, fieldName)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return coerceToInt(value, fieldName)
+		if err := checkWholeNumber(ctx, value, targetType, fieldName); err != nil {
+			return nil, err
+		}
+		n, err := coerceToInt(value, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if reflect.Zero(targetType).OverflowInt(n) {
+			return nil, NewParseError(fieldName, value, targetType.String(),
+				fmt.Sprintf("value %d out of range for %s", n, targetKind))
+		}
+		return n, nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return coerceToUint(value, fieldName)
+		if err := checkWholeNumber(ctx, value, targetType, fieldName); err != nil {
+			return nil, err
+		}
+		n, err := coerceToUint(value, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if reflect.Zero(targetType).OverflowUint(n) {
+			return nil, NewParseError(fieldName, value, targetType.String(),
+				fmt.Sprintf("value %d out of range for %s", n, targetKind))
+		}
+		return n, nil
 	case reflect.Float32, reflect.Float64:
 		return coerceToFloat(value, targetKind, fieldName)
 	case reflect.Bool:
 		return coerceToBool(value, fieldName)
 	case reflect.Slice:
-		return coerceToSlice(value, targetType, fieldName)
+		return coerceToSlice(ctx, value, targetType, fieldName, format)
 	case reflect.Array:
-		return coerceToArray(value, targetType, fieldName)
+		return coerceToArray(ctx, value, targetType, fieldName, format)
+	case reflect.Map:
+		return coerceToMap(ctx, value, targetType, fieldName, format)
 	case reflect.Struct:
-		return coerceToStructWithFormat(value, targetType, fieldName, format)
+		return coerceToStructWithFormat(ctx, value, targetType, fieldName, format)
 	case reflect.Ptr:
-		return coerceToPointer(value, targetType, fieldName)
+		return coerceToPointer(ctx, value, targetType, fieldName, format)
+	case reflect.Interface:
+		if isVariantTarget(ctx, targetType) {
+			return coerceToVariant(ctx, value, targetType, fieldName, format)
+		}
+		if reflect.TypeOf(value).Implements(targetType) {
+			return withFloatNumbers(value), nil
+		}
+		return nil, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("cannot coerce %T to %s", value, targetType))
 	default:
 		return nil, NewParseError(fieldName, value, targetType.String(),
 			fmt.Sprintf("coercion to %s not supported", targetType))
 	}
 }
 
+// CoercerFunc converts a decoded input value (string, json.Number for JSON numbers,
+// float64 or int for YAML numbers, bool, map, slice, ...) into a value of the type it is
+// registered for. Returning a value of another type is allowed only if it converts to the
+// registered type, e.g. a string for a named string type.
+type CoercerFunc func(value interface{}, fieldName string) (interface{}, error)
+
+// coercers stores registered CoercerFunc values keyed by target type
+var coercers sync.Map // map[reflect.Type]CoercerFunc
+
+// RegisterCoercer registers fn as the coercion for values of typ, replacing the built-in
+// rules and any TextUnmarshaler or json.Unmarshaler implementation for that type. It
+// applies to fields of type typ, pointers to it, and its slice and array elements, but
+// not to nil input values. Registering a nil fn removes the coercer.
+//
+// Fields of a registered type are always coerced, even when the input already matches
+// their Go type, so register coercers before parsing.
+//
+// Example:
+//
+//	type Level int
+//
+//	const (
+//	    LevelLow Level = iota
+//	    LevelHigh
+//	)
+//
+//	model.RegisterCoercer(reflect.TypeOf(Level(0)), func(value interface{}, fieldName string) (interface{}, error) {
+//	    switch value {
+//	    case "low":
+//	        return LevelLow, nil
+//	    case "high":
+//	        return LevelHigh, nil
+//	    }
+//	    return nil, fmt.Errorf("unknown level %v", value)
+//	})
+func RegisterCoercer(typ reflect.Type, fn CoercerFunc) {
+	if fn == nil {
+		coercers.Delete(typ)
+	} else {
+		coercers.Store(typ, fn)
+	}
+
+	// Types that contain typ may now need (or no longer need) map-based coercion
+	mapCoercionTypes.Range(func(key, value interface{}) bool {
+		mapCoercionTypes.Delete(key)
+		return true
+	})
+}
+
+// hasCoercer reports whether a coercer is registered for typ
+func hasCoercer(typ reflect.Type) bool {
+	_, ok := coercers.Load(typ)
+	return ok
+}
+
+// coerceWithRegistered runs the coercer registered for targetType. It reports false
+// when none is registered. Errors that are not already a *ParseError or
+// *ValidationError are wrapped in a *ParseError for the field.
+func coerceWithRegistered(value interface{}, targetType reflect.Type, fieldName string) (interface{}, bool, error) {
+	fn, ok := coercers.Load(targetType)
+	if !ok {
+		return nil, false, nil
+	}
+
+	result, err := fn.(CoercerFunc)(value, fieldName)
+	if err != nil {
+		switch err.(type) {
+		case *ParseError, *ValidationError:
+			return nil, true, err
+		}
+		return nil, true, NewParseError(fieldName, value, targetType.String(), err.Error())
+	}
+
+	resultValue := reflect.ValueOf(result)
+	switch {
+	case !resultValue.IsValid():
+		return reflect.Zero(targetType).Interface(), true, nil
+	case resultValue.Type() == targetType:
+		return result, true, nil
+	case resultValue.Type().ConvertibleTo(targetType):
+		return resultValue.Convert(targetType).Interface(), true, nil
+	default:
+		return nil, true, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("coercer for %s returned %T", targetType, result))
+	}
+}
+
+// coerceWithUnmarshaler re-encodes value and decodes it through the target type's
+// UnmarshalYAML (YAML format only) or UnmarshalJSON method, or passes string values to
+// UnmarshalText. It reports false when none applies, so callers can fall back to the
+// built-in coercion rules.
+func coerceWithUnmarshaler(value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, bool, error) {
+	if targetType.Kind() == reflect.Ptr {
+		return nil, false, nil // coerceToPointer handles the element type
+	}
+
+	ptrType := reflect.PointerTo(targetType)
+	var marshal func(interface{}) ([]byte, error)
+	var unmarshal func([]byte, interface{}) error
+
+	switch {
+	case format == FormatYAML && ptrType.Implements(yamlUnmarshalerType):
+		marshal, unmarshal = yaml.Marshal, yaml.Unmarshal
+	case ptrType.Implements(jsonUnmarshalerType):
+		marshal, unmarshal = json.Marshal, json.Unmarshal
+	case ptrType.Implements(textUnmarshalerType):
+		return coerceWithTextUnmarshaler(value, targetType, fieldName)
+	default:
+		return nil, false, nil
+	}
+
+	raw, err := marshal(value)
+	if err != nil {
+		return nil, true, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("cannot re-encode value for %s: %v", targetType, err))
+	}
+
+	result := reflect.New(targetType)
+	if err := unmarshal(raw, result.Interface()); err != nil {
+		return nil, true, NewParseError(fieldName, value, targetType.String(), err.Error())
+	}
+	return result.Elem().Interface(), true, nil
+}
+
+// coerceWithTextUnmarshaler passes a string value to the target type's UnmarshalText.
+// Non-string values are left to the built-in coercion rules.
+func coerceWithTextUnmarshaler(value interface{}, targetType reflect.Type, fieldName string) (interface{}, bool, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, false, nil
+	}
+
+	result := reflect.New(targetType)
+	if err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+		return nil, true, NewParseError(fieldName, value, targetType.String(), err.Error())
+	}
+	return result.Elem().Interface(), true, nil
+}
+
+// coerceWithScanner passes a scalar value to the target type's sql.Scanner Scan method,
+// converted to the types database drivers use: int64, float64, bool, or string. Objects
+// and arrays, and types that do not implement sql.Scanner, are left to the built-in
+// coercion rules.
+func coerceWithScanner(value interface{}, targetType reflect.Type, fieldName string) (interface{}, bool, error) {
+	if targetType.Kind() == reflect.Ptr || !reflect.PointerTo(targetType).Implements(scannerType) {
+		return nil, false, nil
+	}
+	src, ok := driverValue(value)
+	if !ok {
+		return nil, false, nil
+	}
+
+	result := reflect.New(targetType)
+	if err := result.Interface().(sql.Scanner).Scan(src); err != nil {
+		return nil, true, NewParseError(fieldName, value, targetType.String(), err.Error())
+	}
+	return result.Elem().Interface(), true, nil
+}
+
+// driverValue converts a decoded scalar to the driver.Value type a database would pass
+// to Scan. It reports false for values with no such form.
+func driverValue(value interface{}) (driver.Value, bool) {
+	switch v := value.(type) {
+	case string, bool, int64, float64, time.Time:
+		return v, true
+	case float32:
+		return float64(v), true
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, true
+		}
+		f, err := v.Float64()
+		return f, err == nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return nil, false
+		}
+		return int64(rv.Uint()), true
+	default:
+		return nil, false
+	}
+}
+
 // coerceToString converts various types to string
 func coerceToString(value interface{}, _ string) (string, error) {
 	switch v := value.(type) {
 	case string:
 		return v, nil
+	case json.Number:
+		return v.String(), nil
 	case int, int8, int16, int32, int64:
 		return fmt.Sprintf("%d", v), nil
 	case uint, uint8, uint16, uint32, uint64:
@@ -118,9 +415,27 @@ func coerceToInt(value interface{}, fieldName string) (int64, error) {
 		}
 		return int64(v), nil
 	case float32:
-		return int64(v), nil
+		return coerceToInt(float64(v), fieldName)
 	case float64:
+		// 2^63 is exactly representable; every float64 below it fits in int64
+		if math.IsNaN(v) || v >= 1<<63 || v < -(1<<63) {
+			return 0, NewParseError(fieldName, value, "int64", "value out of range for int64")
+		}
 		return int64(v), nil
+	case json.Number:
+		parsed, err := strconv.ParseInt(v.String(), 10, 64)
+		if err == nil {
+			return parsed, nil
+		}
+		if isIntegerLiteral(v.String()) {
+			return 0, NewParseError(fieldName, value, "int64", "value out of range for int64")
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, NewParseError(fieldName, value, "int64",
+				fmt.Sprintf("cannot parse number %s as integer: %v", v, err))
+		}
+		return coerceToInt(f, fieldName)
 	case string:
 		parsed, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
@@ -178,15 +493,30 @@ func coerceToUint(value interface{}, fieldName string) (uint64, error) {
 		}
 		return uint64(v), nil
 	case float32:
-		if v < 0 {
-			return 0, NewParseError(fieldName, value, "uint64", "negative value cannot be coerced to uint64")
-		}
-		return uint64(v), nil
+		return coerceToUint(float64(v), fieldName)
 	case float64:
 		if v < 0 {
 			return 0, NewParseError(fieldName, value, "uint64", "negative value cannot be coerced to uint64")
 		}
+		// 2^64 is exactly representable; every float64 below it fits in uint64
+		if math.IsNaN(v) || v >= 1<<64 {
+			return 0, NewParseError(fieldName, value, "uint64", "value out of range for uint64")
+		}
 		return uint64(v), nil
+	case json.Number:
+		parsed, err := strconv.ParseUint(v.String(), 10, 64)
+		if err == nil {
+			return parsed, nil
+		}
+		if isIntegerLiteral(v.String()) && !strings.HasPrefix(v.String(), "-") {
+			return 0, NewParseError(fieldName, value, "uint64", "value out of range for uint64")
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, NewParseError(fieldName, value, "uint64",
+				fmt.Sprintf("cannot parse number %s as unsigned integer: %v", v, err))
+		}
+		return coerceToUint(f, fieldName)
 	case string:
 		parsed, err := strconv.ParseUint(v, 10, 64)
 		if err != nil {
@@ -207,42 +537,35 @@ func coerceToUint(value interface{}, fieldName string) (uint64, error) {
 
 // coerceToFloat converts various types to float32/float64
 func coerceToFloat(value interface{}, targetKind reflect.Kind, fieldName string) (float64, error) {
+	bitSize := 64
+	if targetKind == reflect.Float32 {
+		bitSize = 32
+	}
+
+	var result float64
 	switch v := value.(type) {
 	case float32:
 		return float64(v), nil
 	case float64:
 		return v, nil
-	case int:
-		return float64(v), nil
-	case int8:
-		return float64(v), nil
-	case int16:
-		return float64(v), nil
-	case int32:
-		return float64(v), nil
-	case int64:
-		return float64(v), nil
-	case uint:
-		return float64(v), nil
-	case uint8:
-		return float64(v), nil
-	case uint16:
-		return float64(v), nil
-	case uint32:
-		return float64(v), nil
-	case uint64:
-		return float64(v), nil
-	case string:
-		bitSize := 64
-		if targetKind == reflect.Float32 {
-			bitSize = 32
+	case int, int8, int16, int32, int64:
+		result = float64(reflect.ValueOf(v).Int())
+	case uint, uint8, uint16, uint32, uint64:
+		result = float64(reflect.ValueOf(v).Uint())
+	case json.Number:
+		parsed, err := strconv.ParseFloat(v.String(), bitSize)
+		if err != nil {
+			return 0, NewParseError(fieldName, value, "float64",
+				fmt.Sprintf("cannot parse number %s as float: %v", v, err))
 		}
+		result = parsed
+	case string:
 		parsed, err := strconv.ParseFloat(v, bitSize)
 		if err != nil {
 			return 0, NewParseError(fieldName, value, "float64",
 				fmt.Sprintf("cannot parse string %q as float: %v", v, err))
 		}
-		return parsed, nil
+		result = parsed
 	case bool:
 		if v {
 			return 1.0, nil
@@ -252,6 +575,72 @@ func coerceToFloat(value interface{}, targetKind reflect.Kind, fieldName string)
 		return 0, NewParseError(fieldName, value, "float64",
 			fmt.Sprintf("cannot coerce %T to float64", value))
 	}
+
+	if GetStrictFloatPrecision() && !floatRoundTrips(fmt.Sprint(value), result, bitSize) {
+		return 0, NewParseError(fieldName, value, "float64",
+			fmt.Sprintf("value %v cannot be represented exactly as float%d (nearest is %s)",
+				value, bitSize, strconv.FormatFloat(result, 'g', -1, bitSize)))
+	}
+	return result, nil
+}
+
+// isIntegerLiteral reports whether s is an optionally signed run of decimal digits
+func isIntegerLiteral(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// withFloatNumbers returns v with every json.Number, including those inside maps and
+// slices, replaced by its float64 value, so that interface{} fields hold the same values
+// as with encoding/json. Containers are copied rather than modified.
+func withFloatNumbers(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Number:
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+		return val
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(val))
+		for key, elem := range val {
+			converted[key] = withFloatNumbers(elem)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(val))
+		for i, elem := range val {
+			converted[i] = withFloatNumbers(elem)
+		}
+		return converted
+	default:
+		return v
+	}
+}
+
+// floatRoundTrips reports whether the shortest decimal form of f (at bitSize) denotes the
+// same number as the decimal input. Inputs that are not plain decimals (e.g. "Inf", hex
+// floats) are accepted as-is.
+func floatRoundTrips(input string, f float64, bitSize int) bool {
+	input = strings.TrimSpace(input)
+	prec := bigFloatPrecision(input) + 64
+
+	want, _, err := big.ParseFloat(input, 10, prec, big.ToNearestEven)
+	if err != nil {
+		return true
+	}
+	got, _, err := big.ParseFloat(strconv.FormatFloat(f, 'g', -1, bitSize), 10, prec, big.ToNearestEven)
+	if err != nil {
+		return true
+	}
+	return want.Cmp(got) == 0
 }
 
 // coerceToBool converts various types to bool
@@ -275,6 +664,13 @@ func coerceToBool(value interface{}, fieldName string) (bool, error) {
 		return v != 0, nil
 	case float32, float64:
 		return v != 0.0, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return false, NewParseError(fieldName, value, "bool",
+				fmt.Sprintf("cannot parse number %s as boolean", v))
+		}
+		return f != 0.0, nil
 	default:
 		return false, NewParseError(fieldName, value, "bool",
 			fmt.Sprintf("cannot coerce %T to bool", value))
@@ -299,12 +695,116 @@ func coerceToTime(value interface{}, fieldName string) (time.Time, error) {
 	case int:
 		// Unix timestamp (seconds)
 		return time.Unix(int64(v), 0), nil
+	case json.Number:
+		if sec, err := v.Int64(); err == nil {
+			return time.Unix(sec, 0), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return time.Time{}, NewParseError(fieldName, value, "time.Time",
+				fmt.Sprintf("cannot parse number %s as Unix timestamp", v))
+		}
+		return coerceToTime(f, fieldName)
 	default:
 		return time.Time{}, NewParseError(fieldName, value, "time.Time",
 			fmt.Sprintf("cannot coerce %T to time.Time", value))
 	}
 }
 
+// locations caches the time zones named by tz tags
+var locations sync.Map // map[string]*time.Location
+
+// loadLocation returns the named time zone as time.LoadLocation does, caching it so the
+// zone database is read once per name
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// applyTimeZone converts a time.Time, *time.Time, or []time.Time field to the zone named
+// by its tz tag. The instant is unchanged; only the zone used to present it is. Nil
+// pointers are left alone. Unknown zones and other field types are reported as parse
+// errors.
+func applyTimeZone(fieldValue reflect.Value, zone, fieldName string) error {
+	loc, err := loadLocation(zone)
+	if err != nil {
+		return NewParseError(fieldName, fieldValue.Interface(), fieldValue.Type().String(),
+			fmt.Sprintf("unknown time zone %q", zone))
+	}
+
+	target := fieldValue
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			return nil
+		}
+		target = target.Elem()
+	}
+
+	switch {
+	case target.Type() == timeType:
+		target.Set(reflect.ValueOf(target.Interface().(time.Time).In(loc)))
+	case target.Kind() == reflect.Slice && target.Type().Elem() == timeType:
+		for i := 0; i < target.Len(); i++ {
+			elem := target.Index(i)
+			elem.Set(reflect.ValueOf(elem.Interface().(time.Time).In(loc)))
+		}
+	default:
+		return NewParseError(fieldName, fieldValue.Interface(), fieldValue.Type().String(),
+			"tz applies only to time.Time fields")
+	}
+	return nil
+}
+
+// coerceToDuration converts strings via time.ParseDuration and numbers as nanoseconds
+func coerceToDuration(value interface{}, fieldName string) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		s := strings.TrimSpace(v)
+		if d, err := time.ParseDuration(s); err == nil {
+			return d, nil
+		}
+		// Bare numbers without a unit are nanoseconds, matching numeric input
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Duration(n), nil
+		}
+		return 0, NewParseError(fieldName, v, "time.Duration",
+			fmt.Sprintf("cannot parse string %q as time.Duration (expected e.g. \"30s\", \"1h30m\")", v))
+	case float64:
+		// float64(math.MaxInt64) rounds up to 2^63, so compare against 2^63 itself
+		if v != math.Trunc(v) || v >= 1<<63 || v < -(1<<63) {
+			return 0, NewParseError(fieldName, v, "time.Duration",
+				fmt.Sprintf("cannot convert %g to time.Duration nanoseconds", v))
+		}
+		return time.Duration(v), nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return time.Duration(n), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, NewParseError(fieldName, v, "time.Duration",
+				fmt.Sprintf("cannot convert %s to time.Duration nanoseconds", v))
+		}
+		return coerceToDuration(f, fieldName)
+	default:
+		n, err := coerceToInt(value, fieldName)
+		if err != nil {
+			return 0, NewParseError(fieldName, value, "time.Duration",
+				fmt.Sprintf("cannot coerce %T to time.Duration", value))
+		}
+		return time.Duration(n), nil
+	}
+}
+
 // parseTimeFromString attempts to parse time from string using multiple formats.
 // Formats are ordered by likelihood: RFC3339 variants first (most common in APIs),
 // then ISO 8601, then common date/time formats.
@@ -343,14 +843,18 @@ func parseTimeFromString(s, fieldName string) (time.Time, error) {
 }
 
 // coerceToSlice converts JSON arrays to Go slices with element coercion
-func coerceToSlice(value interface{}, targetType reflect.Type, fieldName string) (interface{}, error) {
+func coerceToSlice(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
 	if value == nil {
 		// Return zero slice for nil
 		return reflect.Zero(targetType).Interface(), nil
 	}
 
-	// Handle JSON arrays ([]interface{})
+	// Handle JSON arrays ([]interface{}). A single XML element is a one-element slice,
+	// since XML only repeats elements that occur more than once.
 	sourceSlice, ok := value.([]interface{})
+	if !ok && format == FormatXML {
+		sourceSlice, ok = []interface{}{value}, true
+	}
 	if !ok {
 		return nil, NewParseError(fieldName, value, targetType.String(),
 			fmt.Sprintf("cannot coerce %T to slice", value))
@@ -358,30 +862,88 @@ func coerceToSlice(value interface{}, targetType reflect.Type, fieldName string)
 
 	elementType := targetType.Elem()
 	sliceLen := len(sourceSlice)
+	if err := checkArrayLength(fieldName, sliceLen, GetMaxArrayLength()); err != nil {
+		return nil, err
+	}
 
 	// Create new slice with proper type
 	resultSlice := reflect.MakeSlice(targetType, sliceLen, sliceLen)
 
 	// Coerce each element
 	for i, elem := range sourceSlice {
-		coercedElem, err := CoerceValue(elem, elementType, fmt.Sprintf("%s[%d]", fieldName, i))
+		coercedElem, err := coerceValueContext(ctx, elem, elementType, fmt.Sprintf("%s[%d]", fieldName, i), format)
 		if err != nil {
 			return nil, err
 		}
 
-		// Set the element in the result slice
-		elemValue := reflect.ValueOf(coercedElem)
-		if elemValue.Type().ConvertibleTo(elementType) {
-			elemValue = elemValue.Convert(elementType)
-		}
-		resultSlice.Index(i).Set(elemValue)
+		// Set the element in the result slice; null interface elements stay nil
+		resultSlice.Index(i).Set(convertTo(coercedElem, elementType))
 	}
 
 	return resultSlice.Interface(), nil
 }
 
+// coerceToMap converts data objects to Go maps, coercing each key from its string form
+// and each value to the map's element type. Keys are processed in sorted order so the
+// reported error is deterministic.
+func coerceToMap(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
+	sourceMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("cannot coerce %T to map", value))
+	}
+
+	keys := make([]string, 0, len(sourceMap))
+	for key := range sourceMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	keyType := targetType.Key()
+	elementType := targetType.Elem()
+	resultMap := reflect.MakeMapWithSize(targetType, len(sourceMap))
+
+	// Object keys are always strings, and encoding/json parses them into integer key
+	// types too, so NoCoerce applies only to the values
+	keyCtx := ctx
+	if opts := coerceOptionsFrom(ctx); opts.NoCoerce {
+		opts.NoCoerce = false
+		keyCtx = withCoerceOptions(ctx, opts)
+	}
+
+	for _, key := range keys {
+		elemName := fmt.Sprintf("%s[%s]", fieldName, key)
+
+		coercedKey, err := coerceValueContext(keyCtx, key, keyType, elemName, format)
+		if err != nil {
+			return nil, err
+		}
+		coercedElem, err := coerceValueContext(ctx, sourceMap[key], elementType, elemName, format)
+		if err != nil {
+			return nil, err
+		}
+
+		resultMap.SetMapIndex(convertTo(coercedKey, keyType), convertTo(coercedElem, elementType))
+	}
+
+	return resultMap.Interface(), nil
+}
+
+// convertTo returns v as a reflect.Value of type t, converting between kinds where
+// needed (e.g. the int64 produced by coercion into an int key)
+func convertTo(v interface{}, t reflect.Type) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return reflect.Zero(t)
+	}
+	if rv.Type() != t && rv.Type().ConvertibleTo(t) {
+		rv = rv.Convert(t)
+	}
+	return rv
+}
+
 // coerceToArray converts JSON arrays to Go arrays with element coercion
-func coerceToArray(value interface{}, targetType reflect.Type, fieldName string) (interface{}, error) {
+func coerceToArray(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
 	if value == nil {
 		// Return zero array for nil
 		return reflect.Zero(targetType).Interface(), nil
@@ -408,7 +970,7 @@ func coerceToArray(value interface{}, targetType reflect.Type, fieldName string)
 
 	// Coerce each element
 	for i, elem := range sourceSlice {
-		coercedElem, err := CoerceValue(elem, elementType, fmt.Sprintf("%s[%d]", fieldName, i))
+		coercedElem, err := coerceValueContext(ctx, elem, elementType, fmt.Sprintf("%s[%d]", fieldName, i), format)
 		if err != nil {
 			return nil, err
 		}
@@ -425,14 +987,21 @@ func coerceToArray(value interface{}, targetType reflect.Type, fieldName string)
 }
 
 // coerceToStructWithFormat converts objects to Go structs recursively with format awareness
-func coerceToStructWithFormat(value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
+func coerceToStructWithFormat(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
 	if value == nil {
 		// Return zero value for nil
 		return reflect.Zero(targetType).Interface(), nil
 	}
 
-	// Handle data objects (map[string]interface{})
+	// Precomputed field plan for this struct type (cached for performance)
+	schema := getStructSchema(targetType, format)
+
+	// Handle data objects (map[string]interface{}). A text-only XML element fills the
+	// struct's xml:",chardata" field.
 	sourceMap, ok := value.(map[string]interface{})
+	if text, isText := value.(string); isText && format == FormatXML && schema.hasKey(xmlTextKey) {
+		sourceMap, ok = map[string]interface{}{xmlTextKey: text}, true
+	}
 	if !ok {
 		return nil, NewParseError(fieldName, value, targetType.String(),
 			fmt.Sprintf("cannot coerce %T to struct", value))
@@ -440,51 +1009,60 @@ func coerceToStructWithFormat(value interface{}, targetType reflect.Type, fieldN
 
 	// Create new instance of the target struct
 	resultValue := reflect.New(targetType).Elem()
-
-	// Parse validation rules for this struct type
-	validation := ParseValidationTags(targetType)
 	var errors ErrorList
+	coercionFailed := make([]bool, len(schema.fields))
 
 	// Process each field in the nested struct
-	for i := 0; i < targetType.NumField(); i++ {
-		field := targetType.Field(i)
-		fieldValue := resultValue.Field(i)
+	for i := range schema.fields {
+		field := &schema.fields[i]
 
-		// Skip unexported fields
-		if !fieldValue.CanSet() {
-			continue
+		// Get value from data map; absent fields take their default or are left as zero values
+		rawValue, present := field.lookup(sourceMap)
+		if !present && field.hasDefault {
+			rawValue = field.defaultValue
 		}
+		nestedFieldName := fmt.Sprintf("%s.%s", fieldName, field.name)
 
-		// Get field key from appropriate tag (json or yaml), fallback to field name
-		fieldKey := getFieldKey(field, format)
-		if fieldKey == "-" {
-			continue // Skip fields with tag:"-"
+		// Recursively coerce and set the value
+		if err := field.set(field.inputContext(ctx, present), resultValue, rawValue, nestedFieldName, format); err != nil {
+			errors.Add(err)
+			coercionFailed[i] = true // Skip validation if coercion failed
+			if failFast(ctx, errors) {
+				return nil, errors.AsError()
+			}
 		}
+	}
 
-		// Get value from data map
-		rawValue, exists := sourceMap[fieldKey]
-		nestedFieldName := fmt.Sprintf("%s.%s", fieldName, field.Name)
-
-		if !exists {
-			// Field not present in data, leave as zero value
-			rawValue = nil
+	// Validation pass - runs after all fields are set so cross-field validators see the full struct
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		rules := field.rulesFor(nil) // grouped rules only run via ValidateGroups
+		if coercionFailed[i] || len(rules) == 0 {
+			continue
 		}
 
-		// Recursively coerce and set the value
-		if err := setFieldValue(fieldValue, rawValue, nestedFieldName, format); err != nil {
-			errors.Add(err)
-			continue // Skip validation if coercion failed
+		fieldValue, ok := fieldForRead(resultValue, field.index)
+		if !ok {
+			continue
 		}
 
 		// Apply validation rules to nested fields
-		if err := validateFieldValue(field.Name, fieldKey, fieldValue.Interface(), validation); err != nil {
+		if err := validateValueContext(ctx, field.name, fieldValue.Interface(), rules, resultValue); err != nil {
 			// Update error to include nested path
-			updatedErr := updateFieldPaths(err, nestedFieldName, field.Name)
-			errors.Add(updatedErr)
+			errors.Add(prefixFieldPaths(err, fieldName))
+			if failFast(ctx, errors) {
+				break
+			}
 		}
 	}
 
-	if errors.HasErrors() {
+	// Object-level invariants run only once every field is valid
+	if !errors.hasFatal() {
+		errors.Add(prefixFieldPaths(runSelfValidation(resultValue), fieldName))
+	}
+
+	// Warnings alone do not fail coercion
+	if errors.hasFatal() {
 		return nil, errors.AsError()
 	}
 
@@ -501,7 +1079,7 @@ func getZeroValueForType(t reflect.Type) interface{} {
 	switch t.Kind() {
 	case reflect.Slice:
 		return reflect.MakeSlice(t, 0, 0).Interface()
-	case reflect.Array:
+	case reflect.Array, reflect.Map:
 		return reflect.Zero(t).Interface()
 	case reflect.Struct:
 		return reflect.Zero(t).Interface()
@@ -532,33 +1110,29 @@ func getZeroValue(kind reflect.Kind) interface{} {
 	}
 }
 
-// updateFieldPaths recursively updates field paths in validation errors to include nested paths
-func updateFieldPaths(err error, nestedFieldName, _ string) error {
+// prefixFieldPaths prepends prefix to the paths of validation and parse errors, recursing
+// into error lists. Validation errors keep their leaf Field name and carry the full path
+// in FieldPath; parse errors carry the full path in Field.
+func prefixFieldPaths(err error, prefix string) error {
 	switch e := err.(type) {
 	case *ValidationError:
-		// Create a copy to avoid modifying the original
-		return &ValidationError{
-			Field:   nestedFieldName,
-			Value:   e.Value,
-			Rule:    e.Rule,
-			Message: e.Message,
+		updated := *e
+		path := e.FieldPath
+		if path == "" {
+			path = e.Field
 		}
+		updated.FieldPath = joinFieldPath(prefix, path)
+		return &updated
 	case ErrorList:
-		// Handle multiple validation errors
-		var updatedErrors ErrorList
+		var updated ErrorList
 		for _, innerErr := range e {
-			updatedErr := updateFieldPaths(innerErr, nestedFieldName, "")
-			updatedErrors.Add(updatedErr)
+			updated.Add(prefixFieldPaths(innerErr, prefix))
 		}
-		return updatedErrors
+		return updated
 	case *ParseError:
-		// Update parse errors as well
-		return &ParseError{
-			Field:   nestedFieldName,
-			Value:   e.Value,
-			Type:    e.Type,
-			Message: e.Message,
-		}
+		updated := *e
+		updated.Field = joinFieldPath(prefix, e.Field)
+		return &updated
 	default:
 		// For other error types, return as-is
 		return err
@@ -566,7 +1140,7 @@ func updateFieldPaths(err error, nestedFieldName, _ string) error {
 }
 
 // coerceToPointer handles pointer types by coercing to the underlying type and creating a pointer
-func coerceToPointer(value interface{}, targetType reflect.Type, fieldName string) (interface{}, error) {
+func coerceToPointer(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
 	// If value is nil, return a nil pointer
 	if value == nil {
 		return reflect.Zero(targetType).Interface(), nil
@@ -576,7 +1150,7 @@ func coerceToPointer(value interface{}, targetType reflect.Type, fieldName strin
 	elemType := targetType.Elem()
 
 	// Coerce the value to the element type
-	coercedValue, err := CoerceValue(value, elemType, fieldName)
+	coercedValue, err := coerceValueContext(ctx, value, elemType, fieldName, format)
 	if err != nil {
 		return nil, err
 	}
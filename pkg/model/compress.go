@@ -0,0 +1,64 @@
+package model
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// gzipMagic is the two-byte header that starts every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ParseIntoCompressed is like ParseInto but accepts gzip-compressed input. Input that
+// starts with the gzip header is decompressed before format detection; anything else is
+// parsed as is, so callers need not know whether a payload was compressed.
+//
+// Both the compressed and the decompressed size are checked against MaxInputSize, and
+// decompression stops as soon as the limit is passed, so a small compressed bomb cannot
+// expand without bound.
+//
+// Example:
+//
+//	cfg, err := model.ParseIntoCompressed[Config](gzippedData)
+func ParseIntoCompressed[T any](raw []byte) (T, error) {
+	var zero T
+	if err := checkInputSize(len(raw)); err != nil {
+		return zero, err
+	}
+
+	if bytes.HasPrefix(raw, gzipMagic) {
+		decompressed, err := decompressGzip(raw)
+		if err != nil {
+			return zero, err
+		}
+		raw = decompressed
+	}
+	return ParseInto[T](raw)
+}
+
+// decompressGzip decompresses a gzip stream, reading at most one byte past MaxInputSize
+// so oversized output is rejected without buffering it
+func decompressGzip(raw []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, NewParseError("", nil, "", fmt.Sprintf("gzip decompress error: %v", err))
+	}
+	defer reader.Close()
+
+	limited := io.Reader(reader)
+	maxSize := GetMaxInputSize()
+	if maxSize > 0 {
+		limited = io.LimitReader(reader, int64(maxSize)+1)
+	}
+
+	decompressed, err := io.ReadAll(limited)
+	if err != nil {
+		return nil, NewParseError("", nil, "", fmt.Sprintf("gzip decompress error: %v", err))
+	}
+	if maxSize > 0 && len(decompressed) > maxSize {
+		return nil, NewParseError("", nil, "",
+			fmt.Sprintf("decompressed input exceeds maximum allowed size %d bytes", maxSize))
+	}
+	return decompressed, nil
+}
@@ -29,7 +29,13 @@ var configValues struct {
 	maxCacheSize           int
 	maxValidationDepth     int
 	maxStructureDepth      int
+	maxArrayLength         int
+	strictFloatPrecision   bool
+	caseInsensitiveKeys    bool
+	nullStrings            []string
 	sensitiveFieldPatterns []string
+	errorListFormatter     ErrorListFormatter
+	ruleHTTPStatuses       map[string]int
 }
 
 // initConfigOnce ensures configuration is initialized from exported variables
@@ -39,6 +45,7 @@ func initConfigOnce() {
 		configValues.maxCacheSize = MaxCacheSize
 		configValues.maxValidationDepth = MaxValidationDepth
 		configValues.maxStructureDepth = MaxStructureDepth
+		configValues.maxArrayLength = MaxArrayLength
 		configValues.sensitiveFieldPatterns = append([]string{}, DefaultSensitivePatterns...)
 	})
 }
@@ -131,6 +138,122 @@ func SetMaxStructureDepth(depth int) {
 	MaxStructureDepth = depth
 }
 
+// GetMaxArrayLength returns the maximum number of elements in a parsed array in a
+// thread-safe manner. Default: 0 (unlimited).
+func GetMaxArrayLength() int {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return configValues.maxArrayLength
+}
+
+// SetMaxArrayLength sets the maximum number of elements in a parsed array in a thread-safe
+// manner. Longer arrays are rejected with a ParseError naming their path. Set to 0 to
+// disable the check.
+//
+// Note: This also updates the exported MaxArrayLength variable for compatibility,
+// but that update is not atomic with respect to direct variable reads.
+func SetMaxArrayLength(length int) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	configValues.maxArrayLength = length
+	MaxArrayLength = length
+}
+
+// GetStrictFloatPrecision reports whether float coercion rejects inputs that cannot be
+// represented exactly. Default: false.
+func GetStrictFloatPrecision() bool {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return configValues.strictFloatPrecision
+}
+
+// SetStrictFloatPrecision enables or disables strict float coercion in a thread-safe manner.
+// When enabled, coercing a string or integer into a float field fails with a ParseError if
+// the result does not round-trip to the same decimal value, e.g. "0.10000000000000000001"
+// (more digits than float64 holds) or 9007199254740993 (beyond 2^53). Ordinary values like
+// "19.99" round-trip and are accepted.
+//
+// For exact decimal arithmetic, use a decimal type that implements encoding.TextUnmarshaler
+// or json.Unmarshaler (e.g. shopspring/decimal) instead of float64.
+func SetStrictFloatPrecision(strict bool) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	configValues.strictFloatPrecision = strict
+}
+
+// GetCaseInsensitiveKeys reports whether object keys are matched to struct fields
+// case-insensitively. Default: false.
+func GetCaseInsensitiveKeys() bool {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return configValues.caseInsensitiveKeys
+}
+
+// SetCaseInsensitiveKeys enables or disables case-insensitive key matching in a thread-safe
+// manner. When enabled, a field tagged json:"id" also accepts "ID" or "Id", mirroring the
+// fallback of encoding/json. An exact match always wins over a case-insensitive one.
+//
+// encoding/json already folds case when the input decodes without coercion; enabling this
+// applies the same rule to input that needs coercion and to YAML.
+func SetCaseInsensitiveKeys(enabled bool) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	configValues.caseInsensitiveKeys = enabled
+}
+
+// GetNullStrings returns the strings coerced as null, in a thread-safe manner.
+// Returns a copy of the slice to prevent external modification. Default: none.
+func GetNullStrings() []string {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	result := make([]string, len(configValues.nullStrings))
+	copy(result, configValues.nullStrings)
+	return result
+}
+
+// SetNullStrings sets sentinel strings that coercion treats as null, in a thread-safe
+// manner. A matching input value (compared exactly) leaves a pointer field nil and any
+// other field at its zero value, and a CSV cell or form value is treated as absent, like
+// an empty one. Pass nil to restore the default of coercing such strings normally.
+//
+// Example:
+//
+//	model.SetNullStrings([]string{"null", "NULL", "nil"})
+func SetNullStrings(values []string) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	configValues.nullStrings = append([]string(nil), values...)
+}
+
+// hasNullStrings reports whether any null strings are configured
+func hasNullStrings() bool {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return len(configValues.nullStrings) > 0
+}
+
+// isNullString reports whether s is one of the strings configured with SetNullStrings
+func isNullString(s string) bool {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	for _, null := range configValues.nullStrings {
+		if s == null {
+			return true
+		}
+	}
+	return false
+}
+
 // DefaultSensitivePatterns contains field name patterns that indicate sensitive data.
 // These patterns are matched case-insensitively as substrings of field names.
 // Fields matching these patterns will have their values redacted in error output.
@@ -180,3 +303,56 @@ func AddSensitiveFieldPattern(pattern string) {
 	defer configMu.Unlock()
 	configValues.sensitiveFieldPatterns = append(configValues.sensitiveFieldPatterns, pattern)
 }
+
+// SetErrorListFormatter installs the formatter ErrorList.Error uses, such as
+// LineErrorListFormatter for CLI output. Pass nil to restore DefaultErrorListFormatter.
+// It is safe to call concurrently with parsing.
+//
+// Example:
+//
+//	model.SetErrorListFormatter(model.LineErrorListFormatter)
+func SetErrorListFormatter(format ErrorListFormatter) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	configValues.errorListFormatter = format
+}
+
+// SetRuleHTTPStatus maps validation failures of the named rule to an HTTP status for
+// ErrorList.HTTPStatus, such as 422 Unprocessable Entity for semantic checks that pass
+// syntactic validation. Rules without a mapping report 400 Bad Request. Pass 0 to remove
+// the mapping. It is safe to call concurrently with parsing.
+//
+// Example:
+//
+//	model.SetRuleHTTPStatus("unique_email", http.StatusConflict)
+//	model.SetRuleHTTPStatus("after", http.StatusUnprocessableEntity)
+func SetRuleHTTPStatus(rule string, status int) {
+	initConfigOnce()
+	configMu.Lock()
+	defer configMu.Unlock()
+	if status == 0 {
+		delete(configValues.ruleHTTPStatuses, rule)
+		return
+	}
+	if configValues.ruleHTTPStatuses == nil {
+		configValues.ruleHTTPStatuses = make(map[string]int)
+	}
+	configValues.ruleHTTPStatuses[rule] = status
+}
+
+// getRuleHTTPStatus returns the HTTP status mapped to rule, or 0 if none is
+func getRuleHTTPStatus(rule string) int {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return configValues.ruleHTTPStatuses[rule]
+}
+
+// getErrorListFormatter returns the installed ErrorListFormatter, or nil for the default
+func getErrorListFormatter() ErrorListFormatter {
+	initConfigOnce()
+	configMu.RLock()
+	defer configMu.RUnlock()
+	return configValues.errorListFormatter
+}
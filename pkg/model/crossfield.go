@@ -0,0 +1,336 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// timeType is the reflect.Type of time.Time, used to special-case time comparisons
+var timeType = reflect.TypeOf(time.Time{})
+
+// requiredIfValidator implements the built-in required_if cross-field validator.
+// Tag form: `validate:"required_if=Field value"`. The field is required when the
+// referenced field equals the given value. If no value is given, the field is
+// required whenever the referenced field is non-empty.
+func requiredIfValidator(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+	return validateRequiredCondition("required_if", true, fieldName, fieldValue, structValue, params)
+}
+
+// requiredUnlessValidator implements the built-in required_unless cross-field validator.
+// Tag form: `validate:"required_unless=Field value"`. The field is required unless
+// the referenced field equals the given value. If no value is given, the field is
+// required unless the referenced field is non-empty.
+func requiredUnlessValidator(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+	return validateRequiredCondition("required_unless", false, fieldName, fieldValue, structValue, params)
+}
+
+// validateRequiredCondition enforces presence of fieldValue depending on whether the
+// referenced field matches the expected value. When requireOnMatch is true the field
+// is required on a match (required_if); otherwise it is required on a mismatch (required_unless).
+func validateRequiredCondition(rule string, requireOnMatch bool, fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+	otherName, expected, hasExpected := parseConditionParam(params)
+	if otherName == "" {
+		return NewValidationError(fieldName, fieldValue, rule,
+			fmt.Sprintf("%s requires a field name parameter", rule))
+	}
+
+	otherField, err := lookupField(structValue, otherName)
+	if err != nil {
+		return NewValidationError(fieldName, fieldValue, rule, err.Error())
+	}
+
+	matches, err := fieldMatchesCondition(otherField, otherName, expected, hasExpected)
+	if err != nil {
+		return NewValidationError(fieldName, fieldValue, rule, err.Error())
+	}
+
+	if matches != requireOnMatch || !isEmptyValue(fieldValue) {
+		return nil
+	}
+
+	condition := fmt.Sprintf("%s is set", otherName)
+	if hasExpected {
+		condition = fmt.Sprintf("%s is %s", otherName, expected)
+	}
+
+	verb := "when"
+	if !requireOnMatch {
+		verb = "unless"
+	}
+
+	return NewValidationErrorWithDetails(fieldName, fieldName, fieldValue, rule,
+		fmt.Sprintf("field is required %s %s", verb, condition),
+		map[string]interface{}{"field": otherName, "value": expected})
+}
+
+// parseConditionParam splits a "Field value" tag parameter into the field name and expected value.
+func parseConditionParam(params map[string]interface{}) (field, expected string, hasExpected bool) {
+	raw, ok := params["value"]
+	if !ok {
+		return "", "", false
+	}
+
+	param := strings.TrimSpace(fmt.Sprintf("%v", raw))
+	if idx := strings.IndexAny(param, " \t"); idx > 0 {
+		return param[:idx], strings.TrimSpace(param[idx+1:]), true
+	}
+	return param, "", false
+}
+
+// lookupField returns the named field of a struct value, dereferencing pointers.
+func lookupField(structValue reflect.Value, name string) (reflect.Value, error) {
+	for structValue.Kind() == reflect.Ptr {
+		if structValue.IsNil() {
+			return reflect.Value{}, fmt.Errorf("field %s not found", name)
+		}
+		structValue = structValue.Elem()
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("field %s not found", name)
+	}
+
+	field := structValue.FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("field %s not found", name)
+	}
+	return field, nil
+}
+
+// fieldMatchesCondition reports whether the referenced field equals the expected value.
+// The expected value is coerced to the field's type before comparison. Without an
+// expected value, the condition matches when the field is set, as isSetValue reports.
+func fieldMatchesCondition(field reflect.Value, name, expected string, hasExpected bool) (bool, error) {
+	if !hasExpected {
+		return isSetValue(field.Interface()), nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return false, nil
+		}
+		field = field.Elem()
+	}
+
+	coerced, err := CoerceValue(expected, field.Type(), name)
+	if err != nil {
+		return false, fmt.Errorf("cannot compare %s with %q: %v", name, expected, err)
+	}
+
+	coercedValue := reflect.ValueOf(coerced)
+	if coercedValue.Type() != field.Type() {
+		if !coercedValue.Type().ConvertibleTo(field.Type()) {
+			return false, fmt.Errorf("cannot compare %s with %q", name, expected)
+		}
+		coercedValue = coercedValue.Convert(field.Type())
+	}
+
+	return reflect.DeepEqual(field.Interface(), coercedValue.Interface()), nil
+}
+
+// excludedWithValidator implements the built-in excluded_with cross-field validator.
+// Tag form: `validate:"excluded_with=Field"`. The field must be left empty when the
+// referenced field is set.
+func excludedWithValidator(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+	otherName, _, _ := parseConditionParam(params)
+	if otherName == "" {
+		return NewValidationError(fieldName, fieldValue, "excluded_with",
+			"excluded_with requires a field name parameter")
+	}
+
+	otherField, err := lookupField(structValue, otherName)
+	if err != nil {
+		return NewValidationError(fieldName, fieldValue, "excluded_with", err.Error())
+	}
+
+	if !isSetValue(fieldValue) || !isSetValue(otherField.Interface()) {
+		return nil
+	}
+	return NewValidationErrorWithDetails(fieldName, fieldName, fieldValue, "excluded_with",
+		fmt.Sprintf("%s must not be set when %s is set", fieldName, otherName),
+		map[string]interface{}{"field": otherName})
+}
+
+// mutuallyExclusiveValidator implements the built-in mutually_exclusive cross-field
+// validator. Tag form: `validate:"mutually_exclusive=group"`, or a bare
+// mutually_exclusive for a single unnamed group. At most one field of a group may be
+// set; each set field after the first, in declaration order, reports the conflict.
+func mutuallyExclusiveValidator(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+	if !isSetValue(fieldValue) {
+		return nil
+	}
+	group, _, _ := parseConditionParam(params)
+
+	structValue, ok := derefValue(structValue)
+	if !ok || structValue.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var members []string
+	first := ""
+	for _, field := range getStructSchema(structValue.Type(), FormatJSON).fields {
+		if !inExclusiveGroup(field.rules, group) {
+			continue
+		}
+		members = append(members, field.name)
+
+		value, ok := fieldForRead(structValue, field.index)
+		if first == "" && ok && isSetValue(value.Interface()) {
+			first = field.name
+		}
+	}
+
+	if first == "" || first == fieldName {
+		return nil
+	}
+	return NewValidationErrorWithDetails(fieldName, fieldName, fieldValue, "mutually_exclusive",
+		fmt.Sprintf("%s and %s are mutually exclusive; set only one of %s", first, fieldName, strings.Join(members, ", ")),
+		map[string]interface{}{"field": first, "fields": members})
+}
+
+// inExclusiveGroup reports whether rules include mutually_exclusive for group
+func inExclusiveGroup(rules []ValidationRule, group string) bool {
+	for _, rule := range rules {
+		if rule.Name != "mutually_exclusive" {
+			continue
+		}
+		if name, _, _ := parseConditionParam(rule.Parameters); name == group {
+			return true
+		}
+	}
+	return false
+}
+
+// isSetValue reports whether a field counts as set for exclusion and conditional rules:
+// non-empty as for required, except that false is unset
+func isSetValue(value interface{}) bool {
+	if b, ok := value.(bool); ok {
+		return b
+	}
+	return !isEmptyValue(value)
+}
+
+// fieldComparison describes a built-in numeric cross-field comparison such as gtfield.
+type fieldComparison struct {
+	rule        string
+	description string
+	accept      func(cmp int) bool
+}
+
+// fieldComparisons lists the built-in comparison validators registered in NewValidatorRegistry.
+var fieldComparisons = []fieldComparison{
+	{rule: "gtfield", description: "greater than", accept: func(cmp int) bool { return cmp > 0 }},
+	{rule: "gtefield", description: "greater than or equal to", accept: func(cmp int) bool { return cmp >= 0 }},
+	{rule: "ltfield", description: "less than", accept: func(cmp int) bool { return cmp < 0 }},
+	{rule: "ltefield", description: "less than or equal to", accept: func(cmp int) bool { return cmp <= 0 }},
+}
+
+// crossFieldFunc returns a cross-field validator comparing the field against the
+// field named in the tag parameter, e.g. `validate:"gtfield=MinPrice"`.
+// Supports signed and unsigned integers, floats, and time.Time, including pointers to them.
+// Nil pointers on either side are skipped; use required to enforce presence.
+func (c fieldComparison) crossFieldFunc() CrossFieldValidatorFunc {
+	return func(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error {
+		otherName, _, _ := parseConditionParam(params)
+		if otherName == "" {
+			return NewValidationError(fieldName, fieldValue, c.rule,
+				fmt.Sprintf("%s requires a field name parameter", c.rule))
+		}
+
+		otherField, err := lookupField(structValue, otherName)
+		if err != nil {
+			return NewValidationError(fieldName, fieldValue, c.rule, err.Error())
+		}
+
+		current, ok := derefValue(reflect.ValueOf(fieldValue))
+		if !ok {
+			return nil
+		}
+		other, ok := derefValue(otherField)
+		if !ok {
+			return nil
+		}
+
+		cmp, err := compareValues(current, other)
+		if err != nil {
+			return NewValidationError(fieldName, fieldValue, c.rule,
+				fmt.Sprintf("cannot compare %s with %s: %v", fieldName, otherName, err))
+		}
+
+		if !c.accept(cmp) {
+			return NewValidationErrorWithDetails(fieldName, fieldName, fieldValue, c.rule,
+				fmt.Sprintf("%s must be %s %s", fieldName, c.description, otherName),
+				map[string]interface{}{"field": otherName, "other_value": other.Interface()})
+		}
+		return nil
+	}
+}
+
+// derefValue follows pointers and reports false for invalid values or nil pointers.
+func derefValue(v reflect.Value) (reflect.Value, bool) {
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, v.IsValid()
+}
+
+// compareValues compares two numeric or time.Time values.
+// Returns -1, 0, or 1 when a is less than, equal to, or greater than b.
+func compareValues(a, b reflect.Value) (int, error) {
+	if a.Type() == timeType || b.Type() == timeType {
+		if a.Type() != timeType || b.Type() != timeType {
+			return 0, fmt.Errorf("both fields must be time.Time")
+		}
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time)), nil
+	}
+
+	switch {
+	case isIntKind(a.Kind()) && isIntKind(b.Kind()):
+		return cmpOrdered(a.Int(), b.Int()), nil
+	case isUintKind(a.Kind()) && isUintKind(b.Kind()):
+		return cmpOrdered(a.Uint(), b.Uint()), nil
+	}
+
+	af, aok := numericAsFloat(a)
+	bf, bok := numericAsFloat(b)
+	if !aok || !bok {
+		return 0, fmt.Errorf("unsupported types %s and %s", a.Type(), b.Type())
+	}
+	return cmpOrdered(af, bf), nil
+}
+
+// numericAsFloat converts any integer, unsigned, or float value to float64.
+func numericAsFloat(v reflect.Value) (float64, bool) {
+	switch {
+	case isIntKind(v.Kind()):
+		return float64(v.Int()), true
+	case isUintKind(v.Kind()):
+		return float64(v.Uint()), true
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func isIntKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUintKind(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func cmpOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
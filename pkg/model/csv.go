@@ -0,0 +1,149 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// ParseCSV parses CSV data into a slice of T, applying the same type coercion and
+// validation as ParseInto to every row. The first row holds the column headers.
+//
+// Columns map to struct fields by the csv tag, falling back to the json tag and then
+// the field name. Unknown columns are ignored, and empty cells are treated as absent so
+// that required fails and other fields take their default tag or keep their zero value.
+// A leading UTF-8 BOM (as written by spreadsheet exports) is ignored.
+//
+// Every row is parsed even if an earlier one fails; failures are returned together as
+// an ErrorList whose entries name the row (counting the header as row 1) and column,
+// and the failed rows are left as zero values.
+//
+// Example:
+//
+//	type Product struct {
+//	    SKU   string  `csv:"sku" validate:"required"`
+//	    Price float64 `csv:"price" validate:"min=0"`
+//	}
+//
+//	products, err := model.ParseCSV[Product](data)
+//	if err != nil {
+//	    log.Fatal(err) // e.g. "row 3, column \"price\": ..."
+//	}
+func ParseCSV[T any](data []byte) ([]T, error) {
+	if err := checkInputSize(len(data)); err != nil {
+		return nil, err
+	}
+
+	var zero T
+	typ := reflect.TypeOf(zero)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ParseCSV: target type must be a struct, got %v", typ)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(trimUTF8BOM(data)))
+	reader.FieldsPerRecord = -1 // short rows leave the missing trailing cells absent
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("csv parse error: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if _, exists := columns[name]; !exists {
+			columns[name] = i
+		}
+	}
+
+	schema := getStructSchema(typ, FormatJSON)
+	keys := tagFieldKeys(typ, schema, "csv")
+
+	var results []T
+	var errs ErrorList
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			errs.Add(fmt.Errorf("row %d: csv parse error: %w", row, err))
+			break
+		}
+
+		result, rowErrs := parseCSVRecord[T](record, columns, schema, keys, row)
+		errs = append(errs, rowErrs...)
+		results = append(results, result)
+	}
+
+	return results, errs.AsError()
+}
+
+// parseCSVRecord coerces and validates a single CSV record into T. Errors are
+// prefixed with the row number and the column name of the failing field.
+func parseCSVRecord[T any](record []string, columns map[string]int, schema *structSchema, keys []string, row int) (T, ErrorList) {
+	var zero T
+	var errs ErrorList
+
+	resultValue := reflect.New(reflect.TypeOf(zero)).Elem()
+
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		if keys[i] == "" {
+			continue
+		}
+		cell := ""
+		if col, ok := columns[keys[i]]; ok && col < len(record) {
+			cell = record[col]
+		}
+		if cell == "" || isNullString(cell) {
+			if !field.hasDefault {
+				continue
+			}
+			cell = field.defaultValue
+		}
+
+		if err := field.set(context.Background(), resultValue, cell, field.name, FormatJSON); err != nil {
+			errs.Add(fmt.Errorf("row %d, column %q: %w", row, keys[i], err))
+		}
+	}
+
+	// Validate only once all cells are set, so cross-field validators see the full row
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		rules := field.rulesFor(nil)
+		if len(rules) == 0 {
+			continue
+		}
+
+		fieldValue, ok := fieldForRead(resultValue, field.index)
+		if !ok {
+			continue
+		}
+
+		if err := ValidateValueWithStruct(field.name, fieldValue.Interface(), rules, resultValue); err != nil {
+			errs.Add(fmt.Errorf("row %d, column %q: %w", row, keys[i], err))
+		}
+	}
+
+	// Object-level invariants run only once every field is valid
+	if !errs.hasFatal() {
+		if err := runSelfValidation(resultValue); err != nil {
+			errs.Add(fmt.Errorf("row %d: %w", row, err))
+		}
+	}
+
+	// Warnings alone do not fail the row
+	if errs.hasFatal() {
+		return zero, errs
+	}
+	return resultValue.Interface().(T), nil
+}
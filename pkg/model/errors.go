@@ -5,7 +5,9 @@ package model
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -39,6 +41,25 @@ func NewParseError(field string, value interface{}, targetType, message string)
 	}
 }
 
+// Severity classifies a validation failure. The zero value is SeverityError.
+type Severity int
+
+const (
+	// SeverityError marks a failure that makes parsing and validation fail
+	SeverityError Severity = iota
+	// SeverityWarning marks a non-fatal failure, such as a deprecated but allowed value.
+	// Warnings alone never make ParseInto or Validate fail.
+	SeverityWarning
+)
+
+// String returns "error" or "warning"
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
 // ValidationError represents a validation failure with detailed field and rule information.
 // Supports nested field paths and structured error details for comprehensive error reporting.
 type ValidationError struct {
@@ -48,6 +69,7 @@ type ValidationError struct {
 	Rule      string
 	Message   string
 	Details   map[string]interface{} // Additional structured information
+	Severity  Severity               // SeverityWarning for non-fatal rules (tagged with warn)
 }
 
 func (e ValidationError) Error() string {
@@ -56,10 +78,15 @@ func (e ValidationError) Error() string {
 		fieldName = e.FieldPath
 	}
 
+	kind := "error"
+	if e.Severity == SeverityWarning {
+		kind = "warning"
+	}
+
 	if fieldName != "" {
-		return fmt.Sprintf("validation error on field %q: %s", fieldName, e.Message)
+		return fmt.Sprintf("validation %s on field %q: %s", kind, fieldName, e.Message)
 	}
-	return fmt.Sprintf("validation error: %s", e.Message)
+	return fmt.Sprintf("validation %s: %s", kind, e.Message)
 }
 
 // NewValidationError creates a new ValidationError with basic field and rule information.
@@ -147,21 +174,91 @@ func IsSensitiveField(fieldName string) bool {
 // Provides aggregation, JSON serialization, and structured error reporting capabilities.
 type ErrorList []error
 
+// Error formats the list with the formatter installed by SetErrorListFormatter, or
+// DefaultErrorListFormatter if none is installed. An empty list formats as "".
 func (el ErrorList) Error() string {
+	return el.FormatWith(getErrorListFormatter())
+}
+
+// FormatWith formats the list with format instead of the installed formatter, e.g. to
+// print one error per line in a CLI without changing Error() elsewhere.
+func (el ErrorList) FormatWith(format ErrorListFormatter) string {
 	if len(el) == 0 {
 		return ""
 	}
-	if len(el) == 1 {
-		return el[0].Error()
+	if format == nil {
+		format = DefaultErrorListFormatter
 	}
+	return format(el)
+}
 
-	messages := make([]string, 0, len(el))
-	for _, err := range el {
+// ErrorListFormatter renders a non-empty ErrorList as a single string
+type ErrorListFormatter func(errs ErrorList) string
+
+// DefaultErrorListFormatter returns the only error's message, or "multiple errors: "
+// followed by every message joined with "; ".
+func DefaultErrorListFormatter(errs ErrorList) string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
 		messages = append(messages, err.Error())
 	}
 	return fmt.Sprintf("multiple errors: %s", strings.Join(messages, "; "))
 }
 
+// LineErrorListFormatter puts each error's full message on its own line.
+func LineErrorListFormatter(errs ErrorList) string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// CompactErrorListFormatter renders each error as "path: message", joined with "; ",
+// e.g. "Email: invalid email format; Age: value must be at least 18". Warnings are
+// marked "(warning)"; errors without a field path use their full message.
+func CompactErrorListFormatter(errs ErrorList) string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, compactError(err))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// compactError renders one error for CompactErrorListFormatter
+func compactError(err error) string {
+	switch e := err.(type) {
+	case *ParseError:
+		if e.Field != "" {
+			return fmt.Sprintf("%s: %s", e.Field, e.Message)
+		}
+	case *ValidationError:
+		path := e.FieldPath
+		if path == "" {
+			path = e.Field
+		}
+		message := e.Message
+		if e.Severity == SeverityWarning {
+			message += " (warning)"
+		}
+		if path != "" {
+			return fmt.Sprintf("%s: %s", path, message)
+		}
+		return message
+	}
+	return err.Error()
+}
+
+// Unwrap returns the contained errors so errors.Is and errors.As can match any of them,
+// such as a context.Canceled returned by a context-aware validator
+func (el ErrorList) Unwrap() []error {
+	return el
+}
+
 // Add appends an error to the ErrorList
 // If the error is itself an ErrorList, it flattens the errors to avoid nesting
 func (el *ErrorList) Add(err error) {
@@ -214,11 +311,153 @@ func (el ErrorList) GroupByField() map[string][]*ValidationError {
 	return groups
 }
 
+// Warnings returns the validation errors with SeverityWarning
+func (el ErrorList) Warnings() ErrorList {
+	var warnings ErrorList
+	for _, err := range el {
+		if isWarning(err) {
+			warnings = append(warnings, err)
+		}
+	}
+	return warnings
+}
+
+// Errors returns every entry that is not a warning, including parse errors
+func (el ErrorList) Errors() ErrorList {
+	var errs ErrorList
+	for _, err := range el {
+		if !isWarning(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// hasFatal reports whether the ErrorList contains anything other than warnings
+func (el ErrorList) hasFatal() bool {
+	for _, err := range el {
+		if !isWarning(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWarning reports whether err is a ValidationError with SeverityWarning, or an
+// ErrorList holding only warnings, possibly wrapped with context such as a CSV row
+func isWarning(err error) bool {
+	var list ErrorList
+	if errors.As(err, &list) {
+		return len(list) > 0 && !list.hasFatal()
+	}
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr) && validationErr.Severity == SeverityWarning
+}
+
+// failOnErrors returns err unchanged if it contains a non-warning error, and nil if it
+// holds only warnings. Entry points use it so warnings alone never fail a parse.
+func failOnErrors(err error) error {
+	if err == nil {
+		return nil
+	}
+	if el, ok := err.(ErrorList); ok {
+		if el.hasFatal() {
+			return el
+		}
+		return nil
+	}
+	if isWarning(err) {
+		return nil
+	}
+	return err
+}
+
+// HTTPStatus suggests the HTTP status for responding with the ErrorList, for handlers that
+// write ToJSON output. Validation failures report the status mapped to their rule with
+// SetRuleHTTPStatus, and 400 Bad Request otherwise. Errors with an HTTPStatus() int
+// method, such as BindRequest's rejections of unsupported media types (415) and
+// oversized bodies (413), report that status; parse errors and any other errors report
+// 400. When the errors disagree the result is 400. Warnings are ignored, and a list with
+// no errors reports 200 OK.
+//
+// Example:
+//
+//	w.WriteHeader(errs.HTTPStatus())
+//	w.Write(body)
+func (el ErrorList) HTTPStatus() int {
+	status := 0
+	for _, err := range el {
+		if isWarning(err) {
+			continue
+		}
+
+		errStatus := http.StatusBadRequest
+		var statusErr interface{ HTTPStatus() int }
+		if validationErr, ok := err.(*ValidationError); ok {
+			if mapped := getRuleHTTPStatus(validationErr.Rule); mapped != 0 {
+				errStatus = mapped
+			}
+		} else if errors.As(err, &statusErr) {
+			errStatus = statusErr.HTTPStatus()
+		}
+		if status != 0 && status != errStatus {
+			return http.StatusBadRequest
+		}
+		status = errStatus
+	}
+
+	if status == 0 {
+		return http.StatusOK
+	}
+	return status
+}
+
+// First returns the first error in the ErrorList, or nil if it is empty
+func (el ErrorList) First() error {
+	if len(el) == 0 {
+		return nil
+	}
+	return el[0]
+}
+
+// FilterByRule returns the validation errors produced by the named rule (e.g. "required"),
+// in their original order. The result is empty if no error matches.
+func (el ErrorList) FilterByRule(rule string) ErrorList {
+	var filtered ErrorList
+	for _, err := range el {
+		if validationErr, ok := err.(*ValidationError); ok && validationErr.Rule == rule {
+			filtered = append(filtered, validationErr)
+		}
+	}
+	return filtered
+}
+
+// FieldErrors returns the validation errors for a single field path, matching the keys
+// used by GroupByField (e.g. "Email" or "User.Address.Street")
+func (el ErrorList) FieldErrors(field string) []*ValidationError {
+	var fieldErrors []*ValidationError
+	for _, err := range el {
+		validationErr, ok := err.(*ValidationError)
+		if !ok {
+			continue
+		}
+		fieldPath := validationErr.FieldPath
+		if fieldPath == "" {
+			fieldPath = validationErr.Field
+		}
+		if fieldPath == field {
+			fieldErrors = append(fieldErrors, validationErr)
+		}
+	}
+	return fieldErrors
+}
+
 // StructuredErrorReport represents a structured validation error report for JSON serialization.
 // Provides a comprehensive, machine-readable format for validation errors suitable for APIs.
 type StructuredErrorReport struct {
-	Errors []FieldError `json:"errors"`
-	Count  int          `json:"count"`
+	Errors   []FieldError `json:"errors"`
+	Count    int          `json:"count"`
+	Warnings []FieldError `json:"warnings,omitempty"` // Non-fatal failures, grouped like Errors
 }
 
 // FieldError represents a single field's validation errors.
@@ -238,9 +477,35 @@ type ValidationErrorInfo struct {
 	Details map[string]interface{} `json:"details,omitempty"`
 }
 
-// ToStructuredReport converts an ErrorList to a structured error report for JSON serialization
+// ToStructuredReport converts an ErrorList to a structured error report for JSON serialization.
+// Warnings are reported separately from errors; Count covers only the errors.
 func (el ErrorList) ToStructuredReport() *StructuredErrorReport {
-	fieldGroups := el.GroupByField()
+	fieldErrors := fieldErrorsFromGroups(el.Errors().GroupByField())
+
+	return &StructuredErrorReport{
+		Errors:   fieldErrors,
+		Count:    len(fieldErrors),
+		Warnings: fieldErrorsFromGroups(el.Warnings().GroupByField()),
+	}
+}
+
+// ToStructuredReportWithParseErrors is ToStructuredReport with parse errors and other
+// non-validation errors added after the validation errors, with rule "parse" and keyed by
+// the path of the field they occurred on (empty when the input as a whole was rejected).
+// Count includes them. Use it for request bodies that may fail before validation, whose
+// ToStructuredReport would otherwise be empty; BindHandler responds with it.
+func (el ErrorList) ToStructuredReportWithParseErrors() *StructuredErrorReport {
+	report := el.ToStructuredReport()
+	report.Errors = append(report.Errors, parseFieldErrors(el)...)
+	report.Count = len(report.Errors)
+	return report
+}
+
+// fieldErrorsFromGroups builds FieldError entries from validation errors grouped by path
+func fieldErrorsFromGroups(fieldGroups map[string][]*ValidationError) []FieldError {
+	if len(fieldGroups) == 0 {
+		return []FieldError{}
+	}
 	fieldErrors := make([]FieldError, 0, len(fieldGroups))
 
 	for fieldPath, validationErrors := range fieldGroups {
@@ -270,10 +535,41 @@ func (el ErrorList) ToStructuredReport() *StructuredErrorReport {
 		})
 	}
 
-	return &StructuredErrorReport{
-		Errors: fieldErrors,
-		Count:  len(fieldErrors),
+	return fieldErrors
+}
+
+// parseFieldErrors builds FieldError entries for the entries that are not validation errors
+func parseFieldErrors(el ErrorList) []FieldError {
+	var fieldErrors []FieldError
+	for _, err := range el {
+		if _, ok := err.(*ValidationError); ok || isWarning(err) {
+			continue
+		}
+
+		info := ValidationErrorInfo{Rule: "parse", Message: err.Error()}
+		var fieldPath string
+		var value interface{}
+
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			fieldPath = parseErr.Field
+			value = parseErr.Value
+			if IsSensitiveField(fieldPath) {
+				value = RedactedValue
+			}
+			if err.Error() == parseErr.Error() {
+				info.Message = parseErr.Message // the path is already in the entry
+			}
+		}
+
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:     fieldPath[strings.LastIndex(fieldPath, ".")+1:],
+			FieldPath: fieldPath,
+			Value:     value,
+			Errors:    []ValidationErrorInfo{info},
+		})
 	}
+	return fieldErrors
 }
 
 // ToJSON converts an ErrorList to JSON for API responses
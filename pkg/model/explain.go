@@ -0,0 +1,77 @@
+package model
+
+import (
+	"reflect"
+	"time"
+)
+
+// RuleInfo describes one parsed validation rule, as reported by Explain
+type RuleInfo struct {
+	Name     string      // Validator name, e.g. "min"
+	Param    string      // Parameter text after "=", with quotes removed; empty if none
+	Value    interface{} // Parameter as parsed for the validator (float64 for numbers, otherwise string); nil if none
+	Severity Severity    // SeverityWarning when the rule is followed by warn
+	Groups   []string    // Validation groups of the field; empty means always applied
+}
+
+// Explain returns the validation rules of T and of every struct reachable through its
+// fields, exactly as parsed from the validate tags. Keys are field paths in the form used
+// by ValidationError.FieldPath, with "[]" standing for any slice, array, or map element
+// (e.g. "Items[].SKU"); rules are listed in tag order. Fields without rules are omitted.
+// Use it to check how tags were split, especially quoted parameters containing commas.
+//
+// Example:
+//
+//	for path, rules := range model.Explain[User]() {
+//	    for _, rule := range rules {
+//	        fmt.Printf("%s: %s=%s\n", path, rule.Name, rule.Param)
+//	    }
+//	}
+func Explain[T any]() map[string][]RuleInfo {
+	explained := make(map[string][]RuleInfo)
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	explainType(typ, "", explained, map[reflect.Type]bool{})
+	return explained
+}
+
+// explainType records the rules of the structs held by typ under path. active holds the
+// struct types on the current path, so recursive types are walked once.
+func explainType(typ reflect.Type, path string, explained map[string][]RuleInfo, active map[reflect.Type]bool) {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		if typ.Kind() != reflect.Ptr {
+			path += "[]"
+		}
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || typ == reflect.TypeOf(time.Time{}) || active[typ] {
+		return
+	}
+
+	active[typ] = true
+	defer delete(active, typ)
+
+	for _, field := range getStructSchema(typ, FormatJSON).fields {
+		fieldPath := joinFieldPath(path, field.name)
+
+		if len(field.rules) > 0 {
+			var groups []string
+			if len(field.groups) > 0 {
+				groups = append(groups, field.groups...)
+			}
+
+			rules := make([]RuleInfo, len(field.rules))
+			for i, rule := range field.rules {
+				rules[i] = RuleInfo{
+					Name:     rule.Name,
+					Param:    stringParam(rule.Parameters),
+					Value:    rule.Parameters["value"],
+					Severity: rule.Severity,
+					Groups:   groups,
+				}
+			}
+			explained[fieldPath] = rules
+		}
+
+		explainType(field.typ, fieldPath, explained, active)
+	}
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// ParseForm parses URL-encoded form values or query parameters into a struct of type T,
+// applying the same type coercion and validation as ParseInto.
+//
+// Keys map to struct fields by the form tag, falling back to the json tag and then the
+// field name. Repeated keys fill slice and array fields; other fields take the first
+// value. Empty values are treated as absent, so required fails and other fields take
+// their default tag or keep their zero value, matching how browsers submit blank inputs.
+//
+// Example:
+//
+//	type Search struct {
+//	    Query string   `form:"q" validate:"required"`
+//	    Page  int      `form:"page" validate:"min=1"`
+//	    Tags  []string `form:"tag"`
+//	}
+//
+//	if err := r.ParseForm(); err != nil {
+//	    return err
+//	}
+//	search, err := model.ParseForm[Search](r.Form) // ?q=go&page=2&tag=a&tag=b
+func ParseForm[T any](values url.Values) (T, error) {
+	return parseFormContext[T](context.Background(), values)
+}
+
+// parseFormContext is ParseForm with a context for context-aware validators
+func parseFormContext[T any](ctx context.Context, values url.Values) (T, error) {
+	var zero T
+	var errors ErrorList
+
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	resultType := reflect.TypeOf(zero)
+	if resultType == nil || resultType.Kind() != reflect.Struct {
+		return zero, fmt.Errorf("ParseForm: target type must be a struct, got %v", resultType)
+	}
+	resultValue := reflect.New(resultType).Elem()
+
+	schema := getStructSchema(resultType, FormatJSON)
+	keys := tagFieldKeys(resultType, schema, "form")
+
+	// Parsing and coercion pass
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		if keys[i] == "" {
+			continue
+		}
+
+		rawValue, ok := formFieldValue(values[keys[i]], field.typ)
+		if !ok {
+			if !field.hasDefault {
+				continue
+			}
+			rawValue = field.defaultValue
+		}
+
+		if err := field.set(ctx, resultValue, rawValue, field.name, FormatJSON); err != nil {
+			errors.Add(err)
+		}
+	}
+
+	// Validation pass, run after all fields are set so cross-field validators work
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		rules := field.rulesFor(nil)
+		if len(rules) == 0 {
+			continue
+		}
+
+		fieldValue, ok := fieldForRead(resultValue, field.index)
+		if !ok {
+			continue
+		}
+
+		if err := validateValueContext(ctx, field.name, fieldValue.Interface(), rules, resultValue); err != nil {
+			errors.Add(err)
+		}
+	}
+
+	// Object-level invariants run only once every field is valid
+	if !errors.hasFatal() {
+		errors.Add(runSelfValidation(resultValue))
+	}
+
+	// Warnings alone do not fail the parse
+	if errors.hasFatal() {
+		return zero, errors.AsError()
+	}
+
+	return resultValue.Interface().(T), nil
+}
+
+// formFieldValue selects the raw value for a field from its form values: all values for
+// slice and array fields, otherwise the first. It reports false when the key is absent
+// or its value is empty.
+func formFieldValue(values []string, typ reflect.Type) (interface{}, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) && typ.Elem().Kind() != reflect.Uint8 {
+		items := make([]interface{}, len(values))
+		for i, v := range values {
+			items[i] = v
+		}
+		return items, true
+	}
+
+	if values[0] == "" || isNullString(values[0]) {
+		return nil, false
+	}
+	return values[0], true
+}
@@ -1,14 +1,18 @@
 package model
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 )
 
 // Format represents the input data format for parsing operations.
-// Supports JSON and YAML formats with automatic detection capabilities.
+// Supports JSON, JSON with comments, YAML, and XML formats with automatic detection capabilities.
 type Format int
 
 const (
@@ -16,6 +20,11 @@ const (
 	FormatJSON Format = iota
 	// FormatYAML represents YAML format
 	FormatYAML
+	// FormatJSONC represents JSON with // and /* */ comments and trailing commas,
+	// as used by tsconfig.json and VS Code settings files
+	FormatJSONC
+	// FormatXML represents XML format, with field keys taken from xml tags
+	FormatXML
 )
 
 // FormatParser defines the interface for parsing different data formats.
@@ -29,6 +38,102 @@ type FormatParser interface {
 	Format() Format
 }
 
+// FormatDetector is implemented by registered parsers that can recognize their format.
+// DetectFormat asks each registered detector, in registration order, before applying
+// the built-in JSON and YAML heuristics.
+type FormatDetector interface {
+	// Detect reports whether raw is in the parser's format
+	Detect(raw []byte) bool
+}
+
+var (
+	parsersMu     sync.RWMutex
+	parsers       = map[Format]FormatParser{}
+	parserFormats []Format // registration order, for detection
+)
+
+// RegisterParser adds a parser for a custom format such as HCL or TOML, making format
+// usable with ParseIntoWithFormat, GetParser, and every other format-aware function.
+// Parsers that also implement FormatDetector are consulted by DetectFormat. Registering
+// again for the same format replaces the parser, and a nil parser removes it. The
+// built-in formats cannot be replaced. It is safe to call concurrently with parsing.
+//
+// Custom formats take their field keys from json tags, and their output is checked
+// against MaxStructureDepth and MaxArrayLength like the built-in parsers. Choose format
+// values well above the built-in ones so they never collide with formats added later.
+//
+// Example:
+//
+//	const FormatTOML model.Format = 100
+//
+//	err := model.RegisterParser(FormatTOML, &TOMLParser{})
+//	cfg, err := model.ParseIntoWithFormat[Config](data, FormatTOML)
+func RegisterParser(format Format, parser FormatParser) error {
+	if isBuiltinFormat(format) {
+		return fmt.Errorf("RegisterParser: format %d is built in", format)
+	}
+
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	if _, exists := parsers[format]; exists {
+		for i, registered := range parserFormats {
+			if registered == format {
+				parserFormats = append(parserFormats[:i:i], parserFormats[i+1:]...)
+				break
+			}
+		}
+	}
+	if parser == nil {
+		delete(parsers, format)
+		return nil
+	}
+	parsers[format] = parser
+	parserFormats = append(parserFormats, format)
+	return nil
+}
+
+// isBuiltinFormat reports whether format is one of the formats gopantic parses itself
+func isBuiltinFormat(format Format) bool {
+	return format == FormatJSON || format == FormatYAML || format == FormatJSONC || format == FormatXML
+}
+
+// lookupParser returns the parser registered for a custom format, or nil
+func lookupParser(format Format) FormatParser {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	return parsers[format]
+}
+
+// detectRegistered returns the first registered format whose parser detects raw
+func detectRegistered(raw []byte) (Format, bool) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	for _, format := range parserFormats {
+		if detector, ok := parsers[format].(FormatDetector); ok && detector.Detect(raw) {
+			return format, true
+		}
+	}
+	return 0, false
+}
+
+// limitedParser checks the output of a registered parser against the structure limits
+type limitedParser struct {
+	FormatParser
+}
+
+// Parse parses raw with the registered parser and checks the structure limits
+func (lp limitedParser) Parse(raw []byte) (interface{}, error) {
+	data, err := lp.FormatParser.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStructureLimits(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // JSONParser implements FormatParser for JSON format.
 // Provides high-performance JSON parsing with standard library compatibility.
 type JSONParser struct{}
@@ -36,16 +141,27 @@ type JSONParser struct{}
 // Parse parses JSON data into a generic interface{}
 func (jp *JSONParser) Parse(raw []byte) (interface{}, error) {
 	var data interface{}
-	if err := json.Unmarshal(raw, &data); err != nil {
+	if err := unmarshalJSONNumbers(trimUTF8BOM(raw), &data); err != nil {
 		return nil, fmt.Errorf("json parse error: %w", err)
 	}
-	// Check structure depth to prevent resource exhaustion
-	if err := checkStructureDepth(data); err != nil {
+	// Check structure depth and array lengths to prevent resource exhaustion
+	if err := checkStructureLimits(data); err != nil {
 		return nil, err
 	}
 	return data, nil
 }
 
+// unmarshalJSONNumbers is json.Unmarshal with numbers decoded as json.Number rather than
+// float64, so that integers beyond 2^53 keep every digit until coercion
+func unmarshalJSONNumbers(data []byte, v interface{}) error {
+	if !json.Valid(data) {
+		return json.Unmarshal(data, v) // reports the syntax error exactly as encoding/json does
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
 // Format returns the JSON format type
 func (jp *JSONParser) Format() Format {
 	return FormatJSON
@@ -61,8 +177,8 @@ func (yp *YAMLParser) Parse(raw []byte) (interface{}, error) {
 	if err := yaml.Unmarshal(raw, &data); err != nil {
 		return nil, fmt.Errorf("yaml parse error: %w", err)
 	}
-	// Check structure depth to prevent resource exhaustion
-	if err := checkStructureDepth(data); err != nil {
+	// Check structure depth and array lengths to prevent resource exhaustion
+	if err := checkStructureLimits(data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -77,50 +193,98 @@ func (yp *YAMLParser) Format() Format {
 // Uses heuristic analysis to distinguish between JSON and YAML formats.
 // Returns FormatJSON as the default for ambiguous cases.
 //
+// A UTF-8 byte order mark and leading whitespace are ignored. Leading YAML-only
+// prefixes (# comments, --- document markers, % directives) select YAML, since
+// JSON cannot contain them; otherwise a leading '{' or '[' selects JSON, or JSONC
+// when the content has comments or trailing commas outside strings. Content that
+// starts with a // or /* comment is JSONC, and content starting with '<' is XML.
+// Parsers added with RegisterParser that implement FormatDetector are asked first.
+//
 // Example:
 //
 //	format := model.DetectFormat(data)
 //	result, err := model.ParseIntoWithFormat[MyStruct](data, format)
 func DetectFormat(raw []byte) Format {
-	// Try to detect based on content characteristics
-	if len(raw) == 0 {
+	if format, ok := detectRegistered(raw); ok {
+		return format
+	}
+
+	content, sawYAMLPrefix := skipYAMLPrefixes(string(trimUTF8BOM(raw)))
+
+	if sawYAMLPrefix {
+		return FormatYAML
+	}
+	if content == "" {
 		return FormatJSON // Default to JSON for empty input
 	}
 
-	// Trim whitespace and look at first non-whitespace character
-	for i := 0; i < len(raw); i++ {
-		switch raw[i] {
-		case ' ', '\t', '\n', '\r':
-			continue
-		case '{', '[':
-			return FormatJSON
-		default:
-			// Check for common YAML indicators
-			content := string(raw)
-			// YAML typically has key: value pairs without quotes around keys
-			// or starts with --- document separator
-			if containsYAMLPatterns(content) {
-				return FormatYAML
+	if strings.HasPrefix(content, "//") || strings.HasPrefix(content, "/*") {
+		return FormatJSONC
+	}
+	switch content[0] {
+	case '{', '[':
+		if hasJSONCSyntax(content) {
+			return FormatJSONC
+		}
+		return FormatJSON
+	case '<':
+		return FormatXML
+	}
+
+	// YAML typically has key: value pairs without quotes around keys, or list items
+	if containsYAMLPatterns(content) {
+		return FormatYAML
+	}
+	return FormatJSON // Default to JSON if unsure
+}
+
+// utf8BOM is the byte order mark some editors prepend to UTF-8 files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// trimUTF8BOM removes a leading UTF-8 byte order mark, which encoding/json rejects
+func trimUTF8BOM(raw []byte) []byte {
+	return bytes.TrimPrefix(raw, utf8BOM)
+}
+
+// skipYAMLPrefixes strips leading whitespace, # comment lines, % directive lines, and
+// --- document markers. It reports whether any YAML-only prefix was found.
+func skipYAMLPrefixes(content string) (string, bool) {
+	found := false
+	for {
+		content = strings.TrimLeft(content, " \t\r\n")
+
+		switch {
+		case strings.HasPrefix(content, "#"), strings.HasPrefix(content, "%"):
+			found = true
+			if idx := strings.IndexByte(content, '\n'); idx >= 0 {
+				content = content[idx+1:]
+			} else {
+				content = ""
 			}
-			return FormatJSON // Default to JSON if unsure
+		case isYAMLDocumentMarker(content):
+			found = true
+			content = content[3:]
+		default:
+			return content, found
 		}
 	}
+}
 
-	return FormatJSON // Default to JSON
+// isYAMLDocumentMarker reports whether content starts with a "---" marker on its own
+// or followed by whitespace
+func isYAMLDocumentMarker(content string) bool {
+	if !strings.HasPrefix(content, "---") {
+		return false
+	}
+	return len(content) == 3 || strings.ContainsRune(" \t\r\n", rune(content[3]))
 }
 
 // containsYAMLPatterns checks for common YAML patterns
 func containsYAMLPatterns(content string) bool {
-	return hasYAMLDocumentSeparator(content) ||
-		hasYAMLKeyValuePatterns(content) ||
+	return hasYAMLKeyValuePatterns(content) ||
 		hasYAMLListPatterns(content)
 }
 
-// hasYAMLDocumentSeparator checks for YAML document separator
-func hasYAMLDocumentSeparator(content string) bool {
-	return len(content) >= 3 && content[:3] == "---"
-}
-
 // hasYAMLKeyValuePatterns checks for unquoted key-value patterns
 func hasYAMLKeyValuePatterns(content string) bool {
 	lines := 0
@@ -151,11 +315,12 @@ func hasYAMLListPatterns(content string) bool {
 	return false
 }
 
-// hasUnquotedKeyValue checks if a line has unquoted key:value pattern
+// hasUnquotedKeyValue checks if a line has unquoted key:value pattern, including a key
+// that ends its line and opens a nested mapping
 func hasUnquotedKeyValue(line string) bool {
-	for i := 0; i < len(line)-1; i++ {
+	for i := 0; i < len(line); i++ {
 		if line[i] == ':' && (i == 0 || line[i-1] != '"') &&
-			(i == len(line)-1 || line[i+1] == ' ' || line[i+1] == '\t') {
+			(i == len(line)-1 || line[i+1] == ' ' || line[i+1] == '\t' || line[i+1] == '\r') {
 			return true
 		}
 	}
@@ -196,35 +361,46 @@ func trimLeadingSpace(s string) string {
 	return s[i:]
 }
 
-// checkStructureDepth validates that a parsed structure does not exceed the maximum depth.
-// Returns an error if the structure is too deeply nested.
-func checkStructureDepth(data interface{}) error {
+// checkStructureLimits validates that a parsed structure does not exceed MaxStructureDepth
+// and that no array in it holds more than MaxArrayLength elements. Returns a *ParseError
+// naming the path of the first offending container, with object keys taken in sorted order.
+func checkStructureLimits(data interface{}) error {
 	maxDepth := GetMaxStructureDepth()
-	if maxDepth <= 0 {
-		return nil // depth checking disabled
+	maxLength := GetMaxArrayLength()
+	if maxDepth <= 0 && maxLength <= 0 {
+		return nil // limit checking disabled
 	}
-	return checkDepth(data, 1, maxDepth)
+	return checkLimits(data, 1, maxDepth, maxLength, "")
 }
 
-// checkDepth recursively checks the depth of a parsed structure.
+// checkLimits recursively checks the depth and array lengths of a parsed structure.
 // Only containers (maps and arrays) count as depth levels; primitives don't add depth.
-func checkDepth(v interface{}, currentDepth, maxDepth int) error {
+func checkLimits(v interface{}, currentDepth, maxDepth, maxLength int, path string) error {
 	switch val := v.(type) {
 	case map[string]interface{}:
-		if currentDepth > maxDepth {
-			return fmt.Errorf("structure depth %d exceeds maximum allowed depth of %d", currentDepth, maxDepth)
+		if maxDepth > 0 && currentDepth > maxDepth {
+			return depthError(path, currentDepth, maxDepth)
 		}
-		for _, child := range val {
-			if err := checkDepth(child, currentDepth+1, maxDepth); err != nil {
+		// Visit keys in sorted order so the reported path is the same on every run
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := checkLimits(val[key], currentDepth+1, maxDepth, maxLength, joinFieldPath(path, key)); err != nil {
 				return err
 			}
 		}
 	case []interface{}:
-		if currentDepth > maxDepth {
-			return fmt.Errorf("structure depth %d exceeds maximum allowed depth of %d", currentDepth, maxDepth)
+		if maxDepth > 0 && currentDepth > maxDepth {
+			return depthError(path, currentDepth, maxDepth)
+		}
+		if err := checkArrayLength(path, len(val), maxLength); err != nil {
+			return err
 		}
-		for _, child := range val {
-			if err := checkDepth(child, currentDepth+1, maxDepth); err != nil {
+		for i, child := range val {
+			if err := checkLimits(child, currentDepth+1, maxDepth, maxLength, fmt.Sprintf("%s[%d]", path, i)); err != nil {
 				return err
 			}
 		}
@@ -233,8 +409,24 @@ func checkDepth(v interface{}, currentDepth, maxDepth int) error {
 	return nil
 }
 
+// checkArrayLength rejects arrays longer than maxLength (0 = unlimited) with a *ParseError
+func checkArrayLength(path string, length, maxLength int) error {
+	if maxLength > 0 && length > maxLength {
+		return NewParseError(path, nil, "",
+			fmt.Sprintf("array length %d exceeds maximum allowed length of %d", length, maxLength))
+	}
+	return nil
+}
+
+// depthError reports a container nested beyond the maximum structure depth
+func depthError(path string, depth, maxDepth int) error {
+	return NewParseError(path, nil, "",
+		fmt.Sprintf("structure depth %d exceeds maximum allowed depth of %d", depth, maxDepth))
+}
+
 // GetParser returns the appropriate parser instance for the given format.
 // This function provides access to format-specific parsers for advanced use cases.
+// Formats without a built-in or registered parser get the JSON parser.
 //
 // Example:
 //
@@ -244,7 +436,15 @@ func GetParser(format Format) FormatParser {
 	switch format {
 	case FormatYAML:
 		return &YAMLParser{}
-	default:
+	case FormatJSONC:
+		return &JSONCParser{}
+	case FormatXML:
+		return &XMLParser{}
+	case FormatJSON:
 		return &JSONParser{}
 	}
+	if parser := lookupParser(format); parser != nil {
+		return limitedParser{parser}
+	}
+	return &JSONParser{}
 }
@@ -0,0 +1,109 @@
+package model
+
+// JSONCParser implements FormatParser for JSON with comments (JSONC).
+// Line (//) and block (/* */) comments and trailing commas before a closing
+// bracket are removed, and the result is parsed exactly as JSON.
+type JSONCParser struct{}
+
+// Parse strips comments and trailing commas from JSONC data and parses it as JSON
+func (cp *JSONCParser) Parse(raw []byte) (interface{}, error) {
+	return (&JSONParser{}).Parse(stripJSONC(trimUTF8BOM(raw)))
+}
+
+// Format returns the JSONC format type
+func (cp *JSONCParser) Format() Format {
+	return FormatJSONC
+}
+
+// stripJSONC returns a copy of raw with comments and trailing commas replaced by spaces.
+// String literals are copied untouched, and newlines inside block comments are kept, so
+// line and column numbers in JSON syntax errors still point into the original input. An
+// unterminated block comment is left in place for the JSON decoder to reject.
+func stripJSONC(raw []byte) []byte {
+	out := make([]byte, len(raw))
+	copy(out, raw)
+
+	pendingComma := -1 // offset of the last comma not yet followed by a value
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '"':
+			i = skipJSONString(out, i)
+			pendingComma = -1
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			for i < len(out) && out[i] != '\n' {
+				out[i] = ' '
+				i++
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := blockCommentEnd(out, i)
+			if end < 0 {
+				return out
+			}
+			for ; i < end; i++ {
+				if out[i] != '\n' {
+					out[i] = ' '
+				}
+			}
+			i--
+		case c == ',':
+			pendingComma = i
+		case c == '}' || c == ']':
+			if pendingComma >= 0 {
+				out[pendingComma] = ' '
+			}
+			pendingComma = -1
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		default:
+			pendingComma = -1
+		}
+	}
+	return out
+}
+
+// hasJSONCSyntax reports whether JSON-looking content contains a comment or a trailing
+// comma outside string literals
+func hasJSONCSyntax(content string) bool {
+	afterComma := false
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '"':
+			i = skipJSONString(content, i)
+			afterComma = false
+		case c == '/' && i+1 < len(content) && (content[i+1] == '/' || content[i+1] == '*'):
+			return true
+		case c == ',':
+			afterComma = true
+		case (c == '}' || c == ']') && afterComma:
+			return true
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		default:
+			afterComma = false
+		}
+	}
+	return false
+}
+
+// skipJSONString returns the offset of the quote closing the string literal that opens at
+// start, or the last offset of data when the literal is unterminated
+func skipJSONString[S ~string | ~[]byte](data S, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(data) - 1
+}
+
+// blockCommentEnd returns the offset just past the "*/" closing the block comment that
+// opens at start, or -1 when it is unterminated
+func blockCommentEnd(data []byte, start int) int {
+	for i := start + 2; i+1 < len(data); i++ {
+		if data[i] == '*' && data[i+1] == '/' {
+			return i + 2
+		}
+	}
+	return -1
+}
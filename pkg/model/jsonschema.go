@@ -0,0 +1,435 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// jsonSchemaDraft07 is the meta-schema URI emitted by JSONSchema
+const jsonSchemaDraft07 = "http://json-schema.org/draft-07/schema#"
+
+// schemaNode is a JSON Schema object. Only the keywords gopantic can derive from Go
+// types and validate tags are represented.
+type schemaNode struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Properties           map[string]*schemaNode `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *schemaNode            `json:"items,omitempty"`
+	AdditionalProperties *schemaNode            `json:"additionalProperties,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	AllOf                []*schemaNode          `json:"allOf,omitempty"`
+	AnyOf                []*schemaNode          `json:"anyOf,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	Definitions          map[string]*schemaNode `json:"definitions,omitempty"`
+}
+
+// JSONSchema returns a JSON Schema (draft-07) document describing T as ParseInto reads it.
+// Properties use the same keys as parsing (json tags and promoted embedded fields), and
+// ungrouped validate rules become schema keywords:
+//
+//   - required adds the property to "required"
+//   - min, max, len, and length become minimum/maximum for numbers, minLength/maxLength
+//     for strings, minItems/maxItems for slices, and minProperties/maxProperties for maps
+//   - email, ipv4, and ipv6 set "format"; port bounds integers to 1-65535
+//   - alpha, alphanum, numeric, number, regex, contains, startswith, and endswith become
+//     "pattern" (combined with allOf when a field has several)
+//
+// default tags become "default" and desc tags "description". Rules with no schema equivalent (cross-field, custom,
+// future/past, warnings) are omitted, so the schema may accept values Validate rejects.
+// Named nested structs are emitted once under "definitions" and referenced with $ref;
+// references to T itself point at the root ("#").
+//
+// Example:
+//
+//	schema, err := model.JSONSchema[CreateUserRequest]()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	os.WriteFile("create_user.schema.json", schema, 0o644)
+func JSONSchema[T any]() ([]byte, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+
+	gen := newSchemaGenerator("#/definitions/")
+	gen.root, gen.rootRef = derefType(typ), "#"
+	root, err := gen.rootSchema(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	root.Schema = jsonSchemaDraft07
+	if len(gen.definitions) > 0 {
+		root.Definitions = gen.definitions
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// OpenAPISchemas returns the OpenAPI 3.0 components/schemas entries for T and every
+// named struct it references, as a JSON object keyed by schema name. Schemas are built
+// exactly as by JSONSchema, including descriptions from desc tags, but reference each
+// other with "#/components/schemas/<Name>". T must be a named struct type.
+//
+// Example:
+//
+//	type CreateUser struct {
+//	    Email string `json:"email" validate:"required,email" desc:"Login address"`
+//	}
+//
+//	schemas, err := model.OpenAPISchemas[CreateUser]()
+//	// {"CreateUser": {"type": "object", "properties": {"email": {...}}, "required": ["email"]}}
+func OpenAPISchemas[T any]() ([]byte, error) {
+	typ := derefType(reflect.TypeOf((*T)(nil)).Elem())
+	if typ.Kind() != reflect.Struct || typ.Name() == "" || isScalarStruct(typ) {
+		return nil, fmt.Errorf("OpenAPISchemas: target type must be a named struct, got %v", typ)
+	}
+
+	gen := newSchemaGenerator("#/components/schemas/")
+	if _, err := gen.structRef(typ); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(gen.definitions, "", "  ")
+}
+
+// schemaGenerator walks Go types and builds schemaNodes, collecting named structs as
+// reusable definitions
+type schemaGenerator struct {
+	refPrefix   string                  // Prefix for references to definitions, e.g. "#/definitions/"
+	root        reflect.Type            // Type whose schema is the document root, if any
+	rootRef     string                  // Reference used for recursive uses of root
+	definitions map[string]*schemaNode  // Named struct schemas by definition name
+	names       map[reflect.Type]string // Definition name assigned to each struct type
+}
+
+func newSchemaGenerator(refPrefix string) *schemaGenerator {
+	return &schemaGenerator{
+		refPrefix:   refPrefix,
+		definitions: make(map[string]*schemaNode),
+		names:       make(map[reflect.Type]string),
+	}
+}
+
+var (
+	// rawMessageType is the reflect.Type of json.RawMessage, which holds arbitrary JSON
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	// definitionNameChar matches characters not allowed in definition names
+	definitionNameChar = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+)
+
+// rootSchema returns the inline schema for the root type
+func (g *schemaGenerator) rootSchema(typ reflect.Type) (*schemaNode, error) {
+	typ = derefType(typ)
+	if typ.Kind() == reflect.Struct && !isScalarStruct(typ) {
+		return g.structSchema(typ)
+	}
+	return g.typeSchema(typ)
+}
+
+// typeSchema returns the schema for values of typ, using a reference for named structs
+func (g *schemaGenerator) typeSchema(typ reflect.Type) (*schemaNode, error) {
+	typ = derefType(typ)
+
+	if hasCoercer(typ) || typ == rawMessageType {
+		return &schemaNode{}, nil // any JSON value may be accepted
+	}
+
+	switch typ {
+	case reflect.TypeOf(time.Time{}):
+		return &schemaNode{Type: "string", Format: "date-time"}, nil
+	case durationType, bigIntType:
+		// Parsed from strings such as "30s" or "1e30", and from whole numbers
+		return stringOrNumberSchema("integer"), nil
+	case bigFloatType:
+		return stringOrNumberSchema("number"), nil
+	}
+
+	if reflect.PointerTo(typ).Implements(jsonUnmarshalerType) {
+		return &schemaNode{}, nil // the type decodes itself; its shape is unknown
+	}
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return &schemaNode{Type: "string"}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &schemaNode{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &schemaNode{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &schemaNode{Type: "number"}, nil
+	case reflect.String:
+		return &schemaNode{Type: "string"}, nil
+	case reflect.Interface:
+		return &schemaNode{}, nil
+	case reflect.Slice, reflect.Array:
+		if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+			return &schemaNode{Type: "string", Format: "byte"}, nil // base64, as encoding/json writes it
+		}
+		items, err := g.typeSchema(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		node := &schemaNode{Type: "array", Items: items}
+		if typ.Kind() == reflect.Array {
+			n := typ.Len()
+			node.MinItems, node.MaxItems = &n, &n
+		}
+		return node, nil
+	case reflect.Map:
+		values, err := g.typeSchema(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &schemaNode{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structRef(typ)
+	default:
+		return nil, fmt.Errorf("schema generation not supported for type %s", typ)
+	}
+}
+
+// structRef returns a reference to the definition for a struct type, generating the
+// definition on first use. Anonymous structs are inlined.
+func (g *schemaGenerator) structRef(typ reflect.Type) (*schemaNode, error) {
+	if g.root != nil && typ == g.root {
+		return &schemaNode{Ref: g.rootRef}, nil
+	}
+	if typ.Name() == "" {
+		return g.structSchema(typ)
+	}
+	if name, ok := g.names[typ]; ok {
+		return &schemaNode{Ref: g.refPrefix + name}, nil
+	}
+
+	name := g.definitionName(typ)
+	g.names[typ] = name
+	g.definitions[name] = nil // reserve the name while recursing
+
+	node, err := g.structSchema(typ)
+	if err != nil {
+		return nil, err
+	}
+	g.definitions[name] = node
+	return &schemaNode{Ref: g.refPrefix + name}, nil
+}
+
+// definitionName picks a unique definition name for typ: its Go name, or its
+// package-qualified name when another type already uses the short one
+func (g *schemaGenerator) definitionName(typ reflect.Type) string {
+	name := definitionNameChar.ReplaceAllString(typ.Name(), "_")
+	if _, taken := g.definitions[name]; !taken {
+		return name
+	}
+	return definitionNameChar.ReplaceAllString(typ.String(), "_")
+}
+
+// structSchema builds the object schema for a struct type from its parse schema
+func (g *schemaGenerator) structSchema(typ reflect.Type) (*schemaNode, error) {
+	node := &schemaNode{Type: "object", Properties: make(map[string]*schemaNode)}
+
+	for _, field := range getStructSchema(typ, FormatJSON).fields {
+		prop, err := g.typeSchema(field.typ)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.name, err)
+		}
+
+		rules := field.rulesFor(nil)
+		if hasRequiredRule(rules) {
+			node.Required = append(node.Required, field.key)
+		}
+		description := typ.FieldByIndex(field.index).Tag.Get("desc")
+		if len(rules) > 0 || field.hasDefault || description != "" {
+			prop = constrainField(prop, field, rules, description)
+		}
+
+		node.Properties[field.key] = prop
+	}
+	return node, nil
+}
+
+// stringOrNumberSchema returns a schema accepting either a string or a JSON number of
+// numberType ("integer" or "number")
+func stringOrNumberSchema(numberType string) *schemaNode {
+	return &schemaNode{AnyOf: []*schemaNode{{Type: "string"}, {Type: numberType}}}
+}
+
+// acceptsString reports whether node admits strings, directly or through anyOf
+func acceptsString(node *schemaNode) bool {
+	if node.Type == "string" {
+		return true
+	}
+	for _, alternative := range node.AnyOf {
+		if alternative.Type == "string" {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaDefault returns a field's default tag as a JSON value: the tag text for schemas
+// that accept strings (including durations, big numbers, and times), otherwise the
+// coerced value. Defaults that do not coerce are omitted.
+func schemaDefault(node *schemaNode, field fieldSchema) interface{} {
+	if acceptsString(node) {
+		return field.defaultValue
+	}
+	value, err := CoerceValue(field.defaultValue, derefType(field.typ), field.name)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+// constrainField returns node with the keywords for the field's rules, default tag, and
+// description added. References cannot have sibling keywords in draft-07 or OpenAPI 3.0,
+// so keywords for a referenced type are combined with the reference through allOf.
+func constrainField(node *schemaNode, field fieldSchema, rules []ValidationRule, description string) *schemaNode {
+	if node.Ref == "" {
+		constrained := *node
+		applyRuleKeywords(&constrained, derefType(field.typ), rules)
+		if field.hasDefault {
+			constrained.Default = schemaDefault(&constrained, field)
+		}
+		constrained.Description = description
+		return &constrained
+	}
+
+	extra := &schemaNode{Description: description}
+	applyRuleKeywords(extra, derefType(field.typ), rules)
+	if field.hasDefault {
+		extra.Default = schemaDefault(extra, field)
+	}
+	if reflect.DeepEqual(extra, &schemaNode{}) {
+		return node
+	}
+	extra.AllOf = append([]*schemaNode{node}, extra.AllOf...)
+	return extra
+}
+
+// hasRequiredRule reports whether rules include a fatal required rule
+func hasRequiredRule(rules []ValidationRule) bool {
+	for _, rule := range rules {
+		if rule.Name == "required" && rule.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRuleKeywords adds the schema keywords equivalent to the field's validation rules
+func applyRuleKeywords(node *schemaNode, typ reflect.Type, rules []ValidationRule) {
+	var patterns []string
+
+	for _, rule := range rules {
+		if rule.Severity == SeverityWarning {
+			continue
+		}
+
+		switch v := rule.Validator.(type) {
+		case *MinValidator:
+			setBound(node, typ, v.Min, true)
+		case *MaxValidator:
+			setBound(node, typ, v.Max, false)
+		case *LengthValidator:
+			setBound(node, typ, float64(v.Length), true)
+			setBound(node, typ, float64(v.Length), false)
+		case *EmailValidator:
+			node.Format = "email"
+		case *IPValidator:
+			switch v.Version {
+			case 4:
+				node.Format = "ipv4"
+			case 6:
+				node.Format = "ipv6"
+			}
+		case *PortValidator:
+			if node.Type == "integer" {
+				setBound(node, typ, 1, true)
+				setBound(node, typ, 65535, false)
+			}
+		case *AlphaValidator:
+			patterns = append(patterns, alphaRegex.String())
+		case *AlphanumValidator:
+			patterns = append(patterns, alphanumRegex.String())
+		case *NumericValidator:
+			patterns = append(patterns, numericRegex.String())
+		case *NumberValidator:
+			patterns = append(patterns, numberRegex.String())
+		case *RegexValidator:
+			patterns = append(patterns, v.Pattern)
+		case *ContainsValidator:
+			patterns = append(patterns, regexp.QuoteMeta(v.Substring))
+		case *StartsWithValidator:
+			patterns = append(patterns, "^"+regexp.QuoteMeta(v.Prefix))
+		case *EndsWithValidator:
+			patterns = append(patterns, regexp.QuoteMeta(v.Suffix)+"$")
+		}
+	}
+
+	if len(patterns) == 1 {
+		node.Pattern = patterns[0]
+		return
+	}
+	for _, pattern := range patterns {
+		node.AllOf = append(node.AllOf, &schemaNode{Pattern: pattern})
+	}
+}
+
+// setBound applies a min (lower) or max bound using the keyword that matches the
+// field's kind, mirroring how MinValidator and MaxValidator treat each kind
+func setBound(node *schemaNode, typ reflect.Type, bound float64, lower bool) {
+	n := int(bound)
+	switch {
+	case node.Type == "integer" || node.Type == "number":
+		if lower {
+			node.Minimum = &bound
+		} else {
+			node.Maximum = &bound
+		}
+	case typ.Kind() == reflect.String:
+		if lower {
+			node.MinLength = &n
+		} else {
+			node.MaxLength = &n
+		}
+	case typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array:
+		if lower {
+			node.MinItems = &n
+		} else {
+			node.MaxItems = &n
+		}
+	case typ.Kind() == reflect.Map:
+		if lower {
+			node.MinProperties = &n
+		} else {
+			node.MaxProperties = &n
+		}
+	}
+}
+
+// derefType strips pointer indirections from typ
+func derefType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// isScalarStruct reports whether typ is a struct that is represented as a scalar value
+func isScalarStruct(typ reflect.Type) bool {
+	return typ == reflect.TypeOf(time.Time{}) || typ == bigIntType || typ == bigFloatType
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// ParseMerged decodes each source, deep-merges the results in order, and parses the merged
+// object into T with coercion and validation, for layered configuration such as
+// defaults < file < environment < flags. Later sources win: nested objects merge key by
+// key, while scalars, arrays, and nulls replace the earlier value. Validation runs once,
+// on the merged result, so no single layer needs to be valid on its own.
+//
+// Each source is detected as JSON or YAML independently and must decode to an object;
+// empty sources are skipped. Keys are matched with yaml tags when every source is YAML,
+// and with json tags otherwise. Every source is checked against MaxInputSize,
+// MaxStructureDepth, and MaxArrayLength.
+//
+// Example:
+//
+//	cfg, err := model.ParseMerged[Config](defaults, fileData, envOverrides)
+func ParseMerged[T any](sources ...[]byte) (T, error) {
+	var zero T
+	merged := map[string]interface{}{}
+	decoded, yamlOnly := 0, true
+
+	for i, raw := range sources {
+		if len(bytes.TrimSpace(raw)) == 0 {
+			continue
+		}
+		if err := checkInputSize(len(raw)); err != nil {
+			return zero, fmt.Errorf("source %d: %w", i, err)
+		}
+
+		sourceFormat := DetectFormat(raw)
+		data, err := GetParser(sourceFormat).Parse(raw)
+		if err != nil {
+			return zero, fmt.Errorf("source %d: %w", i, err)
+		}
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return zero, fmt.Errorf("source %d: expected an object, got %T", i, data)
+		}
+
+		decoded++
+		yamlOnly = yamlOnly && sourceFormat == FormatYAML
+		mergeObjects(merged, object)
+	}
+
+	format := FormatJSON
+	if decoded > 0 && yamlOnly {
+		format = FormatYAML
+	}
+	return parseDecoded[T](context.Background(), merged, format)
+}
+
+// mergeObjects deep-merges src into dst. Objects present in both are merged recursively;
+// any other value in src replaces the one in dst. Objects taken from src are copied so
+// later merges never modify a source.
+func mergeObjects(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObject, ok := value.(map[string]interface{})
+		if !ok {
+			dst[key] = value
+			continue
+		}
+
+		dstObject, ok := dst[key].(map[string]interface{})
+		if !ok {
+			dstObject = make(map[string]interface{}, len(srcObject))
+			dst[key] = dstObject
+		}
+		mergeObjects(dstObject, srcObject)
+	}
+}
+
+// Merge overlays the non-zero fields of src onto dst, for applying partial updates such
+// as a PATCH body parsed into the same type. Nested structs are merged field by field;
+// every other non-zero field of src, including non-nil pointers, slices, and maps,
+// replaces the field in dst. Zero fields of src, such as nil pointers, are skipped, so
+// fields absent from a partial update leave dst unchanged. Structs without exported
+// fields, like time.Time, are treated as single values, and unexported fields of dst are
+// never modified. Merge does not validate dst; call Validate afterwards if needed.
+//
+// Example:
+//
+//	update, err := model.ParseInto[User](patchBody)
+//	if err == nil {
+//	    err = model.Merge(&current, &update)
+//	}
+func Merge[T any](dst, src *T) error {
+	if dst == nil || src == nil {
+		return fmt.Errorf("Merge: nil pointer provided")
+	}
+
+	dstValue := reflect.ValueOf(dst).Elem()
+	if dstValue.Kind() != reflect.Struct {
+		return fmt.Errorf("Merge: expected struct, got %v", dstValue.Kind())
+	}
+	mergeStruct(dstValue, reflect.ValueOf(src).Elem())
+	return nil
+}
+
+// mergeStruct overlays the non-zero exported fields of src onto dst
+func mergeStruct(dst, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		if !src.Type().Field(i).IsExported() {
+			continue
+		}
+
+		srcField := src.Field(i)
+		if srcField.IsZero() {
+			continue
+		}
+
+		dstField := dst.Field(i)
+		if srcField.Kind() == reflect.Struct && hasExportedFields(srcField.Type()) {
+			mergeStruct(dstField, srcField)
+			continue
+		}
+		dstField.Set(srcField)
+	}
+}
+
+// hasExportedFields reports whether the struct type typ has any exported field
+func hasExportedFields(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ParseMetrics reports where the time went in one ParseInto-family call.
+type ParseMetrics struct {
+	Type     string // Target type, e.g. "main.User"
+	Format   Format // Input format
+	Size     int    // Input size in bytes
+	FastPath bool   // Whether standard unmarshal handled the input without map-based coercion
+
+	Decode   time.Duration // Decoding raw bytes, including the structure limit check
+	Coerce   time.Duration // Map-based coercion, including validation of nested and flat structs; zero on the fast path
+	Validate time.Duration // Validation of the top-level value
+	Total    time.Duration // Whole call, from input checks to the returned result
+
+	Err error // Error returned by the call, if any
+}
+
+// Observer receives ParseMetrics after each parse. ObserveParse runs synchronously on the
+// parsing goroutine, so it should be fast and safe for concurrent use.
+type Observer interface {
+	ObserveParse(metrics ParseMetrics)
+}
+
+// ObserverFunc adapts a function to the Observer interface.
+type ObserverFunc func(metrics ParseMetrics)
+
+// ObserveParse calls f(metrics).
+func (f ObserverFunc) ObserveParse(metrics ParseMetrics) {
+	f(metrics)
+}
+
+var (
+	observerMu sync.RWMutex
+	observer   Observer
+)
+
+// SetObserver installs an Observer that receives timing for every call to ParseInto and
+// its variants (ParseIntoWithFormat, ParseIntoContext, ParseIntoWithOptions, JSON and YAML
+// BindRequest bodies), including each ParseAll input and CachedParser misses. Pass nil to
+// remove it. Without an observer no timing is collected. It is safe to call concurrently
+// with parsing.
+//
+// Example:
+//
+//	model.SetObserver(model.ObserverFunc(func(m model.ParseMetrics) {
+//	    log.Printf("%s: decode=%v coerce=%v validate=%v fast=%t", m.Type, m.Decode, m.Coerce, m.Validate, m.FastPath)
+//	}))
+func SetObserver(obs Observer) {
+	observerMu.Lock()
+	defer observerMu.Unlock()
+	observer = obs
+}
+
+// getObserver returns the installed Observer, or nil
+func getObserver() Observer {
+	observerMu.RLock()
+	defer observerMu.RUnlock()
+	return observer
+}
+
+// parsePhase identifies a timed section of a parse
+type parsePhase int
+
+const (
+	phaseDecode parsePhase = iota
+	phaseCoerce
+	phaseValidate
+)
+
+// parseTimer accumulates ParseMetrics for one call. A nil *parseTimer records nothing,
+// so instrumented code needs no observer checks.
+type parseTimer struct {
+	metrics ParseMetrics
+}
+
+// parseTimerKey is the context key under which the call's parseTimer is threaded
+type parseTimerKey struct{}
+
+// timerFrom returns the parseTimer carried by ctx, or nil
+func timerFrom(ctx context.Context) *parseTimer {
+	timer, _ := ctx.Value(parseTimerKey{}).(*parseTimer)
+	return timer
+}
+
+// start returns the start time of a phase, or the zero time when not timing
+func (t *parseTimer) start() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// done adds the time since start to phase
+func (t *parseTimer) done(phase parsePhase, start time.Time) {
+	if t == nil {
+		return
+	}
+	elapsed := time.Since(start)
+	switch phase {
+	case phaseDecode:
+		t.metrics.Decode += elapsed
+	case phaseCoerce:
+		t.metrics.Coerce += elapsed
+	case phaseValidate:
+		t.metrics.Validate += elapsed
+	}
+}
+
+// markFastPath records that standard unmarshal handled the input
+func (t *parseTimer) markFastPath() {
+	if t != nil {
+		t.metrics.FastPath = true
+	}
+}
+
+// observeParse runs parse, reporting its metrics to the installed Observer if any
+func observeParse[T any](ctx context.Context, raw []byte, format Format,
+	parse func(context.Context, []byte, Format) (T, error)) (T, error) {
+	obs := getObserver()
+	if obs == nil {
+		return parse(ctx, raw, format)
+	}
+
+	timer := &parseTimer{metrics: ParseMetrics{
+		Type:   reflect.TypeOf((*T)(nil)).Elem().String(),
+		Format: format,
+		Size:   len(raw),
+	}}
+	begin := time.Now()
+	result, err := parse(context.WithValue(ctx, parseTimerKey{}, timer), raw, format)
+	timer.metrics.Total = time.Since(begin)
+	timer.metrics.Err = err
+
+	obs.ObserveParse(timer.metrics)
+	return result, err
+}
@@ -0,0 +1,207 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// CoerceOptions adjusts coercion and validation for a single parse call. The zero value
+// keeps the default lenient, collect-all behavior.
+type CoerceOptions struct {
+	// StrictIntegers rejects numbers with a fractional part, such as 19.99, for integer
+	// fields instead of truncating them. Whole numbers written as floats (19.0, 1e3) are
+	// still accepted.
+	StrictIntegers bool
+
+	// NoCoerce accepts a value only when its decoded type already matches the field, as
+	// encoding/json does: a string for string fields, a number for numeric fields, true or
+	// false for bool fields, and so on. "true" into a bool or 42 into a string fails with
+	// a ParseError. Integer fields also reject fractional numbers, as with StrictIntegers.
+	// Types with their own UnmarshalJSON, UnmarshalYAML, or UnmarshalText still decode
+	// themselves, and registered coercers still apply.
+	NoCoerce bool
+
+	// FailFast stops at the first error, from coercion or validation, and returns only the
+	// errors found so far instead of collecting every error in the input. Use it on hot
+	// paths that only need to know whether input is valid. Warnings do not stop parsing.
+	FailFast bool
+}
+
+// rejectsFractions reports whether integer fields must reject fractional numbers
+func (o CoerceOptions) rejectsFractions() bool {
+	return o.StrictIntegers || o.NoCoerce
+}
+
+// coerceOptionsKey is the context key under which CoerceOptions are threaded through coercion
+type coerceOptionsKey struct{}
+
+// withCoerceOptions returns ctx carrying opts for the coercion functions
+func withCoerceOptions(ctx context.Context, opts CoerceOptions) context.Context {
+	return context.WithValue(ctx, coerceOptionsKey{}, opts)
+}
+
+// coerceOptionsFrom returns the CoerceOptions carried by ctx, or the zero value
+func coerceOptionsFrom(ctx context.Context) CoerceOptions {
+	opts, _ := ctx.Value(coerceOptionsKey{}).(CoerceOptions)
+	return opts
+}
+
+// ParseIntoWithOptions is like ParseInto but applies opts to every coerced value,
+// including nested structs, slices, and maps.
+//
+// Example:
+//
+//	order, err := model.ParseIntoWithOptions[Order](body, model.CoerceOptions{StrictIntegers: true})
+func ParseIntoWithOptions[T any](raw []byte, opts CoerceOptions) (T, error) {
+	ctx := withCoerceOptions(context.Background(), opts)
+	return parseIntoWithFormatContext[T](ctx, raw, DetectFormat(raw))
+}
+
+// ParseIntoStrict is like ParseInto but rejects fractional numbers for integer fields
+// rather than truncating them, so {"quantity": 19.99} fails with a ParseError instead of
+// silently becoming 19. It is shorthand for ParseIntoWithOptions with StrictIntegers set.
+//
+// Example:
+//
+//	order, err := model.ParseIntoStrict[Order](body)
+func ParseIntoStrict[T any](raw []byte) (T, error) {
+	return ParseIntoWithOptions[T](raw, CoerceOptions{StrictIntegers: true})
+}
+
+// ParseIntoNoCoerce is like ParseInto but disables lenient coercion: each value must
+// already have the type of its field, so {"active": "true"} fails for a bool field where
+// ParseInto would accept it. Validation runs as usual. It is shorthand for
+// ParseIntoWithOptions with NoCoerce set.
+//
+// Example:
+//
+//	req, err := model.ParseIntoNoCoerce[CreateUserRequest](body)
+func ParseIntoNoCoerce[T any](raw []byte) (T, error) {
+	return ParseIntoWithOptions[T](raw, CoerceOptions{NoCoerce: true})
+}
+
+// ParseIntoFailFast is like ParseInto but returns as soon as one field fails coercion or
+// validation, skipping the remaining fields. It is shorthand for ParseIntoWithOptions
+// with FailFast set.
+//
+// Example:
+//
+//	if _, err := model.ParseIntoFailFast[Event](payload); err != nil {
+//	    return err // first error only
+//	}
+func ParseIntoFailFast[T any](raw []byte) (T, error) {
+	return ParseIntoWithOptions[T](raw, CoerceOptions{FailFast: true})
+}
+
+// failFast reports whether ctx carries FailFast and errs already holds a fatal error
+func failFast(ctx context.Context, errs ErrorList) bool {
+	return len(errs) > 0 && coerceOptionsFrom(ctx).FailFast && errs.hasFatal()
+}
+
+// checkWholeNumber rejects fractional numbers bound for an integer type when ctx
+// carries StrictIntegers or NoCoerce
+func checkWholeNumber(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string) error {
+	if !coerceOptionsFrom(ctx).rejectsFractions() || !hasFraction(value) {
+		return nil
+	}
+	return NewParseError(fieldName, value, targetType.String(),
+		fmt.Sprintf("value %v has a fractional part and cannot be coerced to %s without truncation", value, targetType.Kind()))
+}
+
+// hasFraction reports whether a numeric value has a fractional part that integer
+// coercion would truncate
+func hasFraction(value interface{}) bool {
+	switch v := value.(type) {
+	case float32:
+		return float64(v) != math.Trunc(float64(v))
+	case float64:
+		return v != math.Trunc(v)
+	case json.Number:
+		if isIntegerLiteral(v.String()) {
+			return false
+		}
+		f, err := v.Float64()
+		return err == nil && f != math.Trunc(f)
+	default:
+		return false
+	}
+}
+
+// checkExactType rejects values whose decoded type does not already match targetType
+// when ctx carries NoCoerce
+func checkExactType(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string) error {
+	if !coerceOptionsFrom(ctx).NoCoerce {
+		return nil
+	}
+	want, ok := expectedInputKind(targetType)
+	got := inputKind(value)
+	if !ok || got == want {
+		return nil
+	}
+	return NewParseError(fieldName, value, targetType.String(),
+		fmt.Sprintf("expected %s for %s, got %s (coercion disabled)", want, targetType, got))
+}
+
+// expectedInputKind names the kind of decoded input that targetType accepts without
+// coercion. It reports false for interfaces and for types that decode themselves.
+func expectedInputKind(targetType reflect.Type) (string, bool) {
+	for targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+
+	switch targetType {
+	case reflect.TypeOf(time.Time{}):
+		return "string", true
+	case durationType, bigIntType, bigFloatType:
+		return "number", true
+	}
+
+	ptrType := reflect.PointerTo(targetType)
+	if ptrType.Implements(jsonUnmarshalerType) || ptrType.Implements(yamlUnmarshalerType) ||
+		ptrType.Implements(textUnmarshalerType) || ptrType.Implements(scannerType) {
+		return "", false
+	}
+
+	switch targetType.Kind() {
+	case reflect.String:
+		return "string", true
+	case reflect.Bool:
+		return "boolean", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number", true
+	case reflect.Slice, reflect.Array:
+		return "array", true
+	case reflect.Map, reflect.Struct:
+		return "object", true
+	default:
+		return "", false
+	}
+}
+
+// inputKind names the kind of a decoded input value in JSON terms
+func inputKind(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number, float32, float64, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64:
+		return "number"
+	}
+
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
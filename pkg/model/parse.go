@@ -1,9 +1,13 @@
 package model
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,17 +19,12 @@ var (
 	// noValidationTypes tracks types that have no validation tags for fast-path
 	noValidationTypes sync.Map // map[reflect.Type]bool
 
-	// fieldKeyCache stores pre-computed field keys per type and format
-	fieldKeyCache sync.Map // map[fieldKeyCacheKey]map[int]string
+	// mapCoercionTypes tracks whether a type declares default or transform tags,
+	// which the standard-unmarshal fast path cannot apply
+	mapCoercionTypes sync.Map // map[reflect.Type]bool
 )
 
-// Note: validationCache is declared in validate.go
-
-// fieldKeyCacheKey is the cache key for field name lookups
-type fieldKeyCacheKey struct {
-	typ    reflect.Type
-	format Format
-}
+// Note: validationCache is declared in validate.go and schemaCache in schema.go
 
 // MaxInputSize is the default maximum size for input data (10MB).
 // Set to 0 to disable size checking. This prevents resource exhaustion
@@ -43,36 +42,35 @@ var MaxInputSize = 10 * 1024 * 1024 // 10MB
 // For concurrent access, use GetMaxStructureDepth() and SetMaxStructureDepth().
 var MaxStructureDepth = 64
 
-// getOrCacheValidation retrieves cached validation tags or parses and caches them
-func getOrCacheValidation(typ reflect.Type) *StructValidation {
-	if cached, ok := validationCache.Load(typ); ok {
-		return cached.(*StructValidation)
-	}
-
-	validation := ParseValidationTags(typ)
-
-	// Check if this type has any validation rules
-	hasValidation := false
-	for _, field := range validation.Fields {
-		if len(field.Rules) > 0 {
-			hasValidation = true
-			break
-		}
-	}
+// MaxArrayLength is the default maximum number of elements in a parsed array (0 = unlimited).
+// Set it when parsing untrusted input so a single huge array cannot force a matching
+// allocation during coercion.
+//
+// WARNING: Direct modification of this variable is NOT thread-safe.
+// For concurrent access, use GetMaxArrayLength() and SetMaxArrayLength().
+var MaxArrayLength = 0
 
-	// Cache the "no validation" state for fast-path
-	if !hasValidation {
-		noValidationTypes.Store(typ, true)
+// checkInputSize rejects inputs larger than MaxInputSize with a *ParseError
+func checkInputSize(size int) error {
+	maxSize := GetMaxInputSize()
+	if maxSize > 0 && size > maxSize {
+		return NewParseError("", nil, "",
+			fmt.Sprintf("input size %d bytes exceeds maximum allowed size %d bytes", size, maxSize))
 	}
-
-	validationCache.Store(typ, validation)
-	return validation
+	return nil
 }
 
 // ParseInto parses raw data into a struct of type T with automatic format detection, type coercion, and validation.
 // The format is automatically detected (JSON or YAML) based on the content structure.
 // This is the main entry point for parsing operations in gopantic.
 //
+// Fields whose key is absent from the input take the value of their default tag, if any,
+// coerced as if it had been given in the input and validated like any other value.
+//
+// T may also be a slice, array, map, pointer, or scalar, e.g. ParseInto[[]User] or
+// ParseInto[map[string]User]; structs inside it are validated, with error paths such as
+// "[1].Name" or "[alice].Name".
+//
 // The function checks input size against MaxInputSize (default 10MB) to prevent resource exhaustion.
 // Set MaxInputSize to 0 to disable size checking.
 //
@@ -90,9 +88,8 @@ func getOrCacheValidation(typ reflect.Type) *StructValidation {
 func ParseInto[T any](raw []byte) (T, error) {
 	// Check input size
 	var zero T
-	maxSize := GetMaxInputSize()
-	if maxSize > 0 && len(raw) > maxSize {
-		return zero, fmt.Errorf("input size %d bytes exceeds maximum allowed size %d bytes", len(raw), maxSize)
+	if err := checkInputSize(len(raw)); err != nil {
+		return zero, err
 	}
 
 	// Auto-detect format and use appropriate parser
@@ -100,6 +97,26 @@ func ParseInto[T any](raw []byte) (T, error) {
 	return ParseIntoWithFormat[T](raw, format)
 }
 
+// MustParseInto is like ParseInto but panics if raw cannot be parsed or validated, like
+// regexp.MustCompile. It is meant for input that is part of the program, such as
+// embedded defaults or configuration loaded at startup where failure should abort; use
+// ParseInto for anything supplied at runtime. The panic value is an error wrapping the
+// parse error, so errors.As still finds it after recover.
+//
+// Example:
+//
+//	//go:embed defaults.yaml
+//	var defaultsYAML []byte
+//
+//	var defaults = model.MustParseInto[Config](defaultsYAML)
+func MustParseInto[T any](raw []byte) T {
+	result, err := ParseInto[T](raw)
+	if err != nil {
+		panic(fmt.Errorf("model: MustParseInto[%s]: %w", reflect.TypeOf((*T)(nil)).Elem(), err))
+	}
+	return result
+}
+
 // ParseIntoWithFormat parses raw data of a specific format into a struct of type T with type coercion and validation.
 // Use this when you know the exact format or want to enforce a specific format.
 // Supports JSON and YAML formats.
@@ -114,48 +131,127 @@ func ParseInto[T any](raw []byte) (T, error) {
 //	    log.Fatal(err)
 //	}
 func ParseIntoWithFormat[T any](raw []byte, format Format) (T, error) {
+	return parseIntoWithFormatContext[T](context.Background(), raw, format)
+}
+
+// ParseIntoContext is like ParseInto but passes ctx to context-aware validators
+// registered with RegisterGlobalContextFunc, so checks that call external services
+// honor the caller's cancellation and deadlines. It returns ctx.Err() without parsing
+// if ctx is already done.
+//
+// Example:
+//
+//	user, err := model.ParseIntoContext[User](r.Context(), body)
+func ParseIntoContext[T any](ctx context.Context, raw []byte) (T, error) {
+	return parseIntoWithFormatContext[T](ctx, raw, DetectFormat(raw))
+}
+
+// ParseMap coerces and validates an already decoded object into T, as ParseInto does for
+// JSON input, without encoding it back to bytes first. Use it for data from another
+// decoder or a merged configuration. Values may be any Go type coercion accepts (string,
+// bool, int, float64, json.Number, ...), but nested objects and arrays must be
+// map[string]interface{} and []interface{}, as produced by encoding/json. The data is
+// checked against MaxStructureDepth and MaxArrayLength; MaxInputSize does not apply.
+//
+// Example:
+//
+//	settings := map[string]interface{}{"port": os.Getenv("PORT"), "debug": true}
+//	cfg, err := model.ParseMap[Config](settings)
+func ParseMap[T any](m map[string]interface{}) (T, error) {
 	var zero T
+	if err := checkStructureLimits(m); err != nil {
+		return zero, err
+	}
+	return parseDecoded[T](context.Background(), m, FormatJSON)
+}
+
+// parseIntoWithFormatContext implements ParseIntoWithFormat, threading ctx through
+// to the validation pass
+func parseIntoWithFormatContext[T any](ctx context.Context, raw []byte, format Format) (T, error) {
+	return observeParse(ctx, raw, format, parseIntoFormat[T])
+}
+
+// parseIntoFormat is parseIntoWithFormatContext without the Observer report
+func parseIntoFormat[T any](ctx context.Context, raw []byte, format Format) (T, error) {
+	var zero T
+	timer := timerFrom(ctx)
+
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
 
 	// Check input size
-	maxSize := GetMaxInputSize()
-	if maxSize > 0 && len(raw) > maxSize {
-		return zero, fmt.Errorf("input size %d bytes exceeds maximum allowed size %d bytes", len(raw), maxSize)
+	if err := checkInputSize(len(raw)); err != nil {
+		return zero, err
 	}
 
-	// Check structure depth to prevent resource exhaustion from deeply nested input
-	if err := checkRawStructureDepth(raw, format); err != nil {
+	// Check structure depth and array lengths to prevent resource exhaustion
+	decodeStart := timer.start()
+	decoded, err := decodeWithLimits(raw, format)
+	if err != nil {
 		return zero, err
 	}
 
 	// Strategy: Try standard unmarshal first (handles json.RawMessage, custom UnmarshalJSON, etc.)
 	// If that succeeds, apply selective coercion only where needed
-	// If it fails (due to type mismatches), fall back to map-based coercion
+	// If it fails (due to type mismatches), fall back to map-based coercion.
+	// Types whose result standard unmarshal cannot match skip the attempt: yaml.v3 panics
+	// on objects bound for registered variant interfaces.
 
 	var result T
-	unmarshalErr := unmarshalByFormat(raw, &result, format)
+	var unmarshalErr error
+	canSkip := canSkipMapCoercion(ctx, reflect.TypeOf(result), format)
+	if canSkip {
+		unmarshalErr = unmarshalByFormat(raw, &result, format)
+	}
+	timer.done(phaseDecode, decodeStart)
 
-	if unmarshalErr == nil {
+	if canSkip && unmarshalErr == nil {
 		// Standard unmarshal succeeded; validate and return
-		// Only validate if T is a struct type
-		val := reflect.ValueOf(&result).Elem()
-		if val.Kind() == reflect.Struct {
-			if err := Validate(&result); err != nil {
-				return zero, err
-			}
+		timer.markFastPath()
+		validateStart := timer.start()
+		err := validateParsed(ctx, reflect.ValueOf(&result).Elem())
+		timer.done(phaseValidate, validateStart)
+		if err != nil {
+			return zero, err
 		}
 		return result, nil
 	}
 
 	// Standard unmarshal failed, fall back to map-based coercion approach
 	// This handles cases where the input has type mismatches that need coercion
-	return parseWithMapCoercion[T](raw, format)
+	return parseWithMapCoercion[T](ctx, raw, format, decoded)
+}
+
+// canSkipMapCoercion reports whether a successful standard unmarshal of typ already
+// matches what map-based coercion would produce. Default, transform, and aliases tags
+// are applied only by map-based coercion, as are null strings. For YAML, so are
+// case-insensitive key matching (encoding/json already folds case) and the checks of
+// StrictIntegers and NoCoerce (yaml.v3 truncates fractional numbers and reads yes/no as
+// booleans where encoding/json rejects both). XML and formats added with RegisterParser
+// have no standard unmarshal and are always decoded by their parser.
+func canSkipMapCoercion(ctx context.Context, typ reflect.Type, format Format) bool {
+	if typeNeedsMapCoercion(typ) || hasNullStrings() || !hasStandardUnmarshal(format) {
+		return false
+	}
+	if format == FormatYAML && (GetCaseInsensitiveKeys() || coerceOptionsFrom(ctx).rejectsFractions()) {
+		return false
+	}
+	return true
+}
+
+// hasStandardUnmarshal reports whether unmarshalByFormat can decode format
+func hasStandardUnmarshal(format Format) bool {
+	return format == FormatJSON || format == FormatJSONC || format == FormatYAML
 }
 
 // unmarshalByFormat unmarshals raw bytes into a value using the appropriate decoder
 func unmarshalByFormat(raw []byte, v interface{}, format Format) error {
 	switch format {
 	case FormatJSON:
-		return json.Unmarshal(raw, v)
+		return json.Unmarshal(trimUTF8BOM(raw), v)
+	case FormatJSONC:
+		return json.Unmarshal(stripJSONC(trimUTF8BOM(raw)), v)
 	case FormatYAML:
 		return yaml.Unmarshal(raw, v)
 	default:
@@ -163,37 +259,57 @@ func unmarshalByFormat(raw []byte, v interface{}, format Format) error {
 	}
 }
 
-// checkRawStructureDepth parses raw bytes and checks if the structure depth exceeds the limit.
-// This is called early in parsing to reject deeply nested input before expensive processing.
-func checkRawStructureDepth(raw []byte, format Format) error {
-	maxDepth := GetMaxStructureDepth()
-	if maxDepth <= 0 {
-		return nil // depth checking disabled
+// checkRawStructureLimits parses raw bytes and checks the structure against MaxStructureDepth
+// and MaxArrayLength. This is called early in parsing to reject oversized input before
+// expensive processing.
+func checkRawStructureLimits(raw []byte, format Format) error {
+	_, err := decodeWithLimits(raw, format)
+	return err
+}
+
+// decodeWithLimits is checkRawStructureLimits returning the decoded data, so map-based
+// coercion can reuse it instead of decoding raw a second time. The data is nil when limit
+// checking is disabled.
+func decodeWithLimits(raw []byte, format Format) (interface{}, error) {
+	if GetMaxStructureDepth() <= 0 && GetMaxArrayLength() <= 0 {
+		return nil, nil // limit checking disabled
 	}
 
-	// Parse into generic interface{} to check structure depth
-	// Note: parser.Parse already calls checkStructureDepth internally,
-	// so this will return the depth error if the structure is too deep
-	parser := GetParser(format)
-	_, err := parser.Parse(raw)
-	return err
+	// Parse into generic interface{} to check the structure
+	// Note: parser.Parse already calls checkStructureLimits internally,
+	// so this will return the limit error if the structure is too deep or an array too long
+	return GetParser(format).Parse(raw)
 }
 
 // parseWithMapCoercion is the fallback parser that uses map-based coercion
-// This is the original gopantic parsing logic
-func parseWithMapCoercion[T any](raw []byte, format Format) (T, error) {
+// This is the original gopantic parsing logic. decoded is raw already decoded by
+// decodeWithLimits, or nil to decode it here.
+func parseWithMapCoercion[T any](ctx context.Context, raw []byte, format Format, decoded interface{}) (T, error) {
 	var zero T
 	var errors ErrorList
+	timer := timerFrom(ctx)
+
+	// Parse into a generic interface{} structure unless the limit check already did
+	data := decoded
+	if data == nil {
+		decodeStart := timer.start()
+		var err error
+		data, err = GetParser(format).Parse(raw)
+		timer.done(phaseDecode, decodeStart)
+		if err != nil {
+			errors.Add(err)
+			return zero, errors.AsError()
+		}
+	}
 
-	// Get the appropriate parser for the format
-	parser := GetParser(format)
+	return parseDecoded[T](ctx, data, format)
+}
 
-	// Parse into a generic interface{} structure
-	data, err := parser.Parse(raw)
-	if err != nil {
-		errors.Add(err)
-		return zero, errors.AsError()
-	}
+// parseDecoded coerces and validates decoded data into a new T
+func parseDecoded[T any](ctx context.Context, data interface{}, format Format) (T, error) {
+	var zero T
+	var errors ErrorList
+	timer := timerFrom(ctx)
 
 	// Create new instance of T
 	resultValue := reflect.New(reflect.TypeOf(zero)).Elem()
@@ -201,8 +317,25 @@ func parseWithMapCoercion[T any](raw []byte, format Format) (T, error) {
 
 	// Handle different target types
 	if resultType.Kind() == reflect.Slice || resultType.Kind() == reflect.Array {
-		// Handle array/slice parsing
-		return parseIntoSlice[T](data, resultType, format)
+		// Handle array/slice parsing; elements are validated as they are coerced
+		coerceStart := timer.start()
+		result, err := parseIntoSlice[T](ctx, data, resultType, format)
+		timer.done(phaseCoerce, coerceStart)
+		return result, err
+	}
+
+	// Maps, pointers, and scalars are coerced as a whole; nested structs are validated
+	// as part of their coercion
+	if resultType.Kind() != reflect.Struct {
+		coerceStart := timer.start()
+		coerced, err := coerceValueContext(ctx, data, resultType, "", format)
+		timer.done(phaseCoerce, coerceStart)
+		if err != nil {
+			errors.Add(err)
+			return zero, errors.AsError()
+		}
+		resultValue.Set(convertTo(coerced, resultType))
+		return resultValue.Interface().(T), nil
 	}
 
 	// Ensure data is a map for struct parsing
@@ -212,81 +345,152 @@ func parseWithMapCoercion[T any](raw []byte, format Format) (T, error) {
 		return zero, errors.AsError()
 	}
 
-	// Parse validation rules for this struct type (cached for performance)
-	validation := getOrCacheValidation(resultType)
-
-	// Process each field in the struct (parsing and coercion pass)
-	for i := 0; i < resultType.NumField(); i++ {
-		field := resultType.Field(i)
-		fieldValue := resultValue.Field(i)
+	// Warnings alone do not fail the parse
+	errors = parseStructMap(ctx, dataMap, resultValue, format)
+	if errors.hasFatal() {
+		return zero, errors.AsError()
+	}
 
-		// Skip unexported fields
-		if !fieldValue.CanSet() {
-			continue
-		}
+	return resultValue.Interface().(T), nil
+}
 
-		// Get field key from appropriate tag (json or yaml), fallback to field name
-		fieldKey := getFieldKey(field, format)
-		if fieldKey == "-" {
-			continue // Skip fields with tag:"-"
+// parseStructMap coerces a decoded object into the struct resultValue, then validates it
+// with cross-field and self validation. It returns every error, warnings included.
+func parseStructMap(ctx context.Context, dataMap map[string]interface{}, resultValue reflect.Value, format Format) ErrorList {
+	var errors ErrorList
+	timer := timerFrom(ctx)
+
+	// Precomputed field plan for this struct type (cached for performance)
+	schema := getStructSchema(resultValue.Type(), format)
+	if schema.flat {
+		coerceStart := timer.start()
+		errors = parseFlatStructMap(ctx, schema, dataMap, resultValue, format)
+		timer.done(phaseCoerce, coerceStart)
+
+		validateStart := timer.start()
+		if !errors.hasFatal() {
+			errors.Add(runSelfValidation(resultValue))
 		}
+		timer.done(phaseValidate, validateStart)
+		return errors
+	}
 
-		// Get value from data map
-		rawValue, exists := dataMap[fieldKey]
-		if !exists {
-			// Field not present in data, leave as zero value
-			rawValue = nil
+	// Process each field in the struct (parsing and coercion pass)
+	coerceStart := timer.start()
+	for i := range schema.fields {
+		field := &schema.fields[i]
+
+		// Get value from data map; absent fields take their default or are left as zero values
+		rawValue, present := field.lookup(dataMap)
+		if !present && field.hasDefault {
+			rawValue = field.defaultValue
 		}
 
-		// Coerce and set the value
-		if err := setFieldValue(fieldValue, rawValue, field.Name, format); err != nil {
+		// Coerce, transform, and set the value
+		if err := field.set(field.inputContext(ctx, present), resultValue, rawValue, field.name, format); err != nil {
 			errors.Add(err)
+			if failFast(ctx, errors) {
+				timer.done(phaseCoerce, coerceStart)
+				return errors
+			}
 		}
 	}
+	timer.done(phaseCoerce, coerceStart)
 
 	// Validation pass - now that all fields are parsed, we can do cross-field validation
-	for i := 0; i < resultType.NumField(); i++ {
-		field := resultType.Field(i)
-		fieldValue := resultValue.Field(i)
-
-		// Skip unexported fields
-		if !fieldValue.CanSet() {
+	validateStart := timer.start()
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		rules := field.rulesFor(nil) // grouped rules only run via ValidateGroups
+		if len(rules) == 0 {
 			continue
 		}
 
-		// Get field key from appropriate tag (json or yaml), fallback to field name
-		fieldKey := getFieldKey(field, format)
-		if fieldKey == "-" {
-			continue // Skip fields with tag:"-"
+		fieldValue, ok := fieldForRead(resultValue, field.index)
+		if !ok {
+			continue
 		}
 
 		// Apply validation rules (including cross-field validators)
-		if err := validateFieldValueWithStruct(field.Name, fieldKey, fieldValue.Interface(), validation, resultValue); err != nil {
+		if err := validateValueContext(ctx, field.name, fieldValue.Interface(), rules, resultValue); err != nil {
 			errors.Add(err)
+			if failFast(ctx, errors) {
+				break
+			}
 		}
 	}
 
-	if errors.HasErrors() {
-		return zero, errors.AsError()
+	// Object-level invariants run only once every field is valid
+	if !errors.hasFatal() {
+		errors.Add(runSelfValidation(resultValue))
 	}
+	timer.done(phaseValidate, validateStart)
 
-	return resultValue.Interface().(T), nil
+	return errors
+}
+
+// parseFlatStructMap is the coercion and validation passes of parseStructMap for a flat
+// schema, run as one loop: no rule reads another field, so each field is validated as
+// soon as it is set. Validation errors still follow all coercion errors, as with two passes.
+func parseFlatStructMap(ctx context.Context, schema *structSchema, dataMap map[string]interface{}, resultValue reflect.Value, format Format) ErrorList {
+	var errors, validationErrors ErrorList
+
+	for i := range schema.fields {
+		field := &schema.fields[i]
+
+		rawValue, present := field.lookup(dataMap)
+		if !present && field.hasDefault {
+			rawValue = field.defaultValue
+		}
+		if err := field.set(field.inputContext(ctx, present), resultValue, rawValue, field.name, format); err != nil {
+			errors.Add(err)
+			if failFast(ctx, errors) {
+				return errors
+			}
+		}
+
+		if rules := field.rulesFor(nil); len(rules) > 0 {
+			fieldValue := resultValue.Field(field.index[0]).Interface()
+			validationErrors.Add(validateValueContext(ctx, field.name, fieldValue, rules, resultValue))
+			if failFast(ctx, validationErrors) {
+				return append(errors, validationErrors...)
+			}
+		}
+	}
+
+	errors = append(errors, validationErrors...)
+	return errors
 }
 
 // setFieldValue coerces and sets a value on a struct field
-func setFieldValue(fieldValue reflect.Value, rawValue interface{}, fieldName string, format Format) error {
+func setFieldValue(ctx context.Context, fieldValue reflect.Value, rawValue interface{}, fieldName string, format Format) error {
 	fieldType := fieldValue.Type()
 	fieldKind := fieldType.Kind()
 
-	// Handle direct assignment for matching types first
-	if rawValue != nil && reflect.TypeOf(rawValue).AssignableTo(fieldType) {
+	// Strings configured with SetNullStrings are coerced like null
+	if s, ok := rawValue.(string); ok && isNullString(s) {
+		rawValue = nil
+	}
+
+	// Values that already match a predeclared field type skip the coercion machinery
+	if setExact(fieldValue, rawValue) {
+		return nil
+	}
+
+	// Handle direct assignment for matching types first, unless a registered coercer owns the
+	// type or the value selects a registered variant
+	if rawValue != nil && reflect.TypeOf(rawValue).AssignableTo(fieldType) && !hasCoercer(fieldType) &&
+		discriminatorFrom(ctx) == "" && !isVariantTarget(ctx, fieldType) {
+		if fieldType != jsonNumberType {
+			rawValue = withFloatNumbers(rawValue) // interface{} fields hold float64, as with encoding/json
+		}
 		fieldValue.Set(reflect.ValueOf(rawValue))
 		return nil
 	}
 
 	// Handle specific types that need special treatment
 	if fieldType == reflect.TypeOf(time.Time{}) {
-		coercedValue, err := CoerceValueWithFormat(rawValue, fieldType, fieldName, format)
+		coercedValue, err := coerceValueContext(ctx, rawValue, fieldType, fieldName, format)
 		if err != nil {
 			return err
 		}
@@ -295,11 +499,17 @@ func setFieldValue(fieldValue reflect.Value, rawValue interface{}, fieldName str
 	}
 
 	// Use coercion for basic type conversion
-	coercedValue, err := CoerceValueWithFormat(rawValue, fieldType, fieldName, format)
+	coercedValue, err := coerceValueContext(ctx, rawValue, fieldType, fieldName, format)
 	if err != nil {
 		return err
 	}
 
+	// Values produced by a type's own unmarshaler already have the exact field type
+	if coerced := reflect.ValueOf(coercedValue); coerced.IsValid() && coerced.Type() == fieldType {
+		fieldValue.Set(coerced)
+		return nil
+	}
+
 	// Set the coerced value based on the field kind
 	switch fieldKind {
 	case reflect.String:
@@ -312,12 +522,18 @@ func setFieldValue(fieldValue reflect.Value, rawValue interface{}, fieldName str
 		fieldValue.SetFloat(coercedValue.(float64))
 	case reflect.Bool:
 		fieldValue.SetBool(coercedValue.(bool))
-	case reflect.Slice, reflect.Array:
+	case reflect.Slice, reflect.Array, reflect.Map:
 		fieldValue.Set(reflect.ValueOf(coercedValue))
 	case reflect.Struct:
 		fieldValue.Set(reflect.ValueOf(coercedValue))
 	case reflect.Ptr:
 		fieldValue.Set(reflect.ValueOf(coercedValue))
+	case reflect.Interface:
+		if coercedValue == nil {
+			fieldValue.Set(reflect.Zero(fieldType))
+		} else {
+			fieldValue.Set(reflect.ValueOf(coercedValue))
+		}
 	default:
 		return NewParseError(fieldName, rawValue, fieldType.String(),
 			fmt.Sprintf("unsupported field type: %s", fieldType))
@@ -326,29 +542,84 @@ func setFieldValue(fieldValue reflect.Value, rawValue interface{}, fieldName str
 	return nil
 }
 
-// getFieldKey extracts the appropriate field key based on the data format
-func getFieldKey(field reflect.StructField, format Format) string {
-	var tagName string
+// setExact sets fieldValue without boxing an intermediate value when rawValue already
+// has the field's type: strings, bools, and float64s, JSON numbers in integer form for
+// integer fields, JSON numbers for float64 fields, and YAML ints. It applies only to
+// predeclared types without a registered coercer, and reports false, leaving the field
+// untouched, whenever coercion could behave differently (out of range, fractional, or
+// StrictFloatPrecision checks).
+func setExact(fieldValue reflect.Value, rawValue interface{}) bool {
+	fieldType := fieldValue.Type()
+	if rawValue == nil || fieldType.PkgPath() != "" || !isPrimitiveKind(fieldType.Kind()) || hasCoercer(fieldType) {
+		return false
+	}
 
-	// Determine which tag to use based on format
-	switch format {
-	case FormatYAML:
-		tagName = "yaml"
+	switch v := rawValue.(type) {
+	case string:
+		if fieldType.Kind() != reflect.String {
+			return false
+		}
+		fieldValue.SetString(v)
+	case bool:
+		if fieldType.Kind() != reflect.Bool {
+			return false
+		}
+		fieldValue.SetBool(v)
+	case float64:
+		if fieldType.Kind() != reflect.Float64 {
+			return false
+		}
+		fieldValue.SetFloat(v)
+	case int:
+		return setExactInt(fieldValue, int64(v))
+	case json.Number:
+		switch fieldType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(v.String(), 10, 64)
+			return err == nil && setExactInt(fieldValue, n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n, err := strconv.ParseUint(v.String(), 10, 64)
+			if err != nil || fieldValue.OverflowUint(n) {
+				return false
+			}
+			fieldValue.SetUint(n)
+		case reflect.Float64:
+			if GetStrictFloatPrecision() {
+				return false
+			}
+			f, err := strconv.ParseFloat(v.String(), 64)
+			if err != nil {
+				return false
+			}
+			fieldValue.SetFloat(f)
+		default:
+			return false
+		}
 	default:
-		tagName = "json"
+		return false
 	}
+	return true
+}
 
-	tag := field.Tag.Get(tagName)
-	if tag == "" {
-		// Fallback to json tag if yaml tag is not present
-		if tagName == "yaml" {
-			tag = field.Tag.Get("json")
-		}
+// setExactInt sets n on a signed integer field if it fits
+func setExactInt(fieldValue reflect.Value, n int64) bool {
+	switch fieldValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return false
+	}
+	if fieldValue.OverflowInt(n) {
+		return false
+	}
+	fieldValue.SetInt(n)
+	return true
+}
 
-		// If still empty, use field name
-		if tag == "" {
-			return field.Name
-		}
+// getFieldKey extracts the appropriate field key based on the data format
+func getFieldKey(field reflect.StructField, format Format) string {
+	tag, _ := fieldKeyTag(field, format)
+	if tag == "" {
+		return field.Name
 	}
 
 	// Handle tag options like "name,omitempty"
@@ -356,9 +627,18 @@ func getFieldKey(field reflect.StructField, format Format) string {
 		return "-"
 	}
 
-	// Split on comma and take first part (the name)
+	// XML character data is kept under its own key
+	if format == FormatXML && hasTagOption(tag, "chardata") {
+		return xmlTextKey
+	}
+
+	// Split on comma and take first part (the name); an empty name such as
+	// ",omitempty" falls back to the field name
 	for i, char := range tag {
 		if char == ',' {
+			if i == 0 {
+				return field.Name
+			}
 			return tag[:i]
 		}
 	}
@@ -366,36 +646,53 @@ func getFieldKey(field reflect.StructField, format Format) string {
 	return tag
 }
 
-// validateFieldValue applies validation rules to a field value
-func validateFieldValue(fieldName, jsonKey string, value interface{}, validation *StructValidation) error {
-	// Find validation rules for this field
-	for _, fieldValidation := range validation.Fields {
-		if fieldValidation.FieldName == fieldName || fieldValidation.JSONKey == jsonKey {
-			// Apply all validation rules for this field
-			return ValidateValue(fieldName, value, fieldValidation.Rules)
-		}
+// fieldKeyTag returns the tag that names the field's key in format: the format's own tag
+// (yaml, xml, or json), then the format-neutral key tag, then for YAML and XML the json
+// tag. fromKey reports whether the key tag was used.
+func fieldKeyTag(field reflect.StructField, format Format) (tag string, fromKey bool) {
+	formatTag := "json"
+	switch format {
+	case FormatYAML:
+		formatTag = "yaml"
+	case FormatXML:
+		formatTag = "xml"
 	}
+	if tag := field.Tag.Get(formatTag); tag != "" {
+		return tag, false
+	}
+	if tag := field.Tag.Get("key"); tag != "" {
+		return tag, true
+	}
+	return field.Tag.Get("json"), false
+}
 
-	// No validation rules found for this field
-	return nil
+// usesKeyTag reports whether the field's key comes from the key tag in JSON or YAML.
+// encoding/json and yaml.v3 ignore that tag, so such fields need map-based coercion.
+func usesKeyTag(field reflect.StructField) bool {
+	_, jsonKey := fieldKeyTag(field, FormatJSON)
+	_, yamlKey := fieldKeyTag(field, FormatYAML)
+	return jsonKey || yamlKey
 }
 
-func validateFieldValueWithStruct(fieldName, jsonKey string, value interface{}, validation *StructValidation, structValue reflect.Value) error {
-	// Find validation rules for this field
-	for _, fieldValidation := range validation.Fields {
-		if fieldValidation.FieldName == fieldName || fieldValidation.JSONKey == jsonKey {
-			// Apply all validation rules for this field (including cross-field validators)
-			return ValidateValueWithStruct(fieldName, value, fieldValidation.Rules, structValue)
-		}
-	}
+// hasFieldKeyTag reports whether the field's json/yaml/key tag explicitly names its key
+func hasFieldKeyTag(field reflect.StructField, format Format) bool {
+	tag, _ := fieldKeyTag(field, format)
 
-	// No validation rules found for this field
-	return nil
+	name := tag
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		name = tag[:idx]
+	}
+	return name != "" && name != "-"
 }
 
 // Validate validates a struct using gopantic validation rules defined in struct tags.
 // This function can be used independently of parsing, allowing you to validate
 // structs that were populated from any source (JSON, YAML, database, environment variables, etc.).
+// Fields with a groups tag are skipped; use ValidateGroups to apply them.
+//
+// v may be a struct or a pointer to one. Validation never modifies v, so struct values
+// need not be addressable. A slice, array, or map of structs (or a pointer to one) has
+// each element validated, with error paths such as "[2].Email" or "[alice].Email".
 //
 // Example:
 //
@@ -408,89 +705,247 @@ func validateFieldValueWithStruct(fieldName, jsonKey string, value interface{},
 //	if err := model.Validate(&user); err != nil {
 //	    log.Fatal(err)
 //	}
-func Validate[T any](v *T) error {
-	if v == nil {
-		return fmt.Errorf("Validate: nil pointer provided")
+//	err := model.Validate(user)   // values work too
+//	err = model.Validate(users)   // []User from a bulk fetch
+func Validate[T any](v T) error {
+	val, err := validationTarget("Validate", v)
+	if err != nil {
+		return err
+	}
+	return failOnErrors(validateTopLevel(context.Background(), val, nil))
+}
+
+// validationTarget returns the struct, slice, array, or map that v holds or points to,
+// reporting nil pointers and other kinds as errors prefixed with the caller's name
+func validationTarget(caller string, v interface{}) (reflect.Value, error) {
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return reflect.Value{}, fmt.Errorf("%s: nil pointer provided", caller)
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return val, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("%s: expected struct, slice, array, or map, got %v", caller, val.Kind())
+	}
+}
+
+// validateTopLevel validates a struct, or the structs held by a pointer, slice, array,
+// or map with paths such as "[0].Email". Only ungrouped rules and rules in one of groups
+// are applied. Warnings are returned too; callers decide whether they fail.
+func validateTopLevel(ctx context.Context, val reflect.Value, groups []string) error {
+	if val.Kind() == reflect.Struct {
+		// Fast path: Skip validation entirely for types with no validation tags
+		if !typeNeedsValidation(val.Type()) {
+			return nil
+		}
+		return validateStructValue(ctx, addressable(val), val.Type(), groups...)
+	}
+
+	elemType := val.Type()
+	for elemType.Kind() == reflect.Ptr || elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array || elemType.Kind() == reflect.Map {
+		elemType = elemType.Elem()
+	}
+	if !holdsStructs(elemType) || !typeNeedsValidation(elemType) {
+		return nil
+	}
+	return validateNested(ctx, val, "", 0, groups)
+}
+
+// addressable returns val, or an addressable copy of it, so pointer-receiver
+// SelfValidator methods run for values passed by value too
+func addressable(val reflect.Value) reflect.Value {
+	if val.CanAddr() {
+		return val
 	}
+	copied := reflect.New(val.Type()).Elem()
+	copied.Set(val)
+	return copied
+}
 
-	val := reflect.ValueOf(v).Elem()
-	typ := val.Type()
+// ValidateDetailed validates a struct, a collection of structs, or a pointer to either like
+// Validate but returns the errors as a typed *ErrorList, or nil when the value is valid, so
+// callers can inspect individual errors or build JSON responses without a type assertion.
+// Invalid arguments, such as a nil pointer or an int, are reported as a single-entry list.
+//
+// Unlike Validate, warnings from rules tagged with warn are returned even when there are
+// no errors; use the list's Errors and Warnings methods to tell them apart.
+//
+// Example:
+//
+//	if errs := model.ValidateDetailed(&user); errs != nil {
+//	    report := errs.ToStructuredReport()
+//	    writeJSON(w, http.StatusBadRequest, report)
+//	    return
+//	}
+func ValidateDetailed(v interface{}) *ErrorList {
+	var errors ErrorList
 
-	if typ.Kind() != reflect.Struct {
-		return fmt.Errorf("Validate: expected struct, got %v", typ.Kind())
+	val, err := validationTarget("ValidateDetailed", v)
+	if err != nil {
+		errors.Add(err)
+		return &errors
 	}
 
-	// Fast path: Skip validation entirely for types with no validation tags
-	if hasNo, ok := noValidationTypes.Load(typ); ok && hasNo.(bool) {
+	errors.Add(validateTopLevel(context.Background(), val, nil))
+	if !errors.HasErrors() {
 		return nil
 	}
+	return &errors
+}
 
-	return validateStructValue(val, typ)
+// ValidateWithContext validates an already-parsed value like Validate, passing ctx to
+// context-aware validators registered with RegisterGlobalContextFunc.
+//
+// Example:
+//
+//	if err := model.ValidateWithContext(r.Context(), &user); err != nil {
+//	    return err
+//	}
+func ValidateWithContext[T any](ctx context.Context, v T) error {
+	val, err := validationTarget("ValidateWithContext", v)
+	if err != nil {
+		return err
+	}
+	return failOnErrors(validateTopLevel(ctx, val, nil))
+}
+
+// ValidateGroups validates an already-parsed value like Validate, additionally applying
+// rules for fields whose groups tag names one of the given groups. Fields without a groups
+// tag are always validated; grouped fields are skipped by Validate and ParseInto.
+//
+// Example:
+//
+//	type UserRequest struct {
+//	    ID    int    `json:"id" validate:"required" groups:"update"`
+//	    Email string `json:"email" validate:"required,email" groups:"create,update"`
+//	    Name  string `json:"name" validate:"max=100"`
+//	}
+//
+//	req, err := model.ParseInto[UserRequest](body) // checks Name only
+//	err = model.ValidateGroups(&req, "create")     // checks Name and Email
+func ValidateGroups[T any](v T, groups ...string) error {
+	val, err := validationTarget("ValidateGroups", v)
+	if err != nil {
+		return err
+	}
+	return failOnErrors(validateTopLevel(context.Background(), val, groups))
 }
 
-// validateStructValue validates a struct value recursively
-func validateStructValue(val reflect.Value, typ reflect.Type) error {
-	return validateStructValueDepth(val, typ, 0)
+// validateStructValue validates a struct value recursively. Only ungrouped rules and
+// rules in one of the given validation groups are applied.
+func validateStructValue(ctx context.Context, val reflect.Value, typ reflect.Type, groups ...string) error {
+	return validateStructValueDepth(ctx, val, typ, 0, groups)
 }
 
 // validateStructValueDepth validates a struct value recursively with depth tracking
 //
 //nolint:gocyclo // Complexity inherited from original validateStructValue function
-func validateStructValueDepth(val reflect.Value, typ reflect.Type, depth int) error {
+func validateStructValueDepth(ctx context.Context, val reflect.Value, typ reflect.Type, depth int, groups []string) error {
 	maxDepth := GetMaxValidationDepth()
 	if maxDepth > 0 && depth > maxDepth {
 		return fmt.Errorf("validation depth exceeded maximum of %d levels", maxDepth)
 	}
 
-	validation := getOrCacheValidation(typ)
+	schema := getStructSchema(typ, FormatJSON)
 	var errors ErrorList
 
-	for i := 0; i < val.NumField(); i++ {
-		field := typ.Field(i)
-		fieldVal := val.Field(i)
-
-		if !fieldVal.CanInterface() {
-			continue
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		fieldVal, ok := fieldForRead(val, field.index)
+		if !ok {
+			continue // embedded struct pointer is nil
 		}
 
-		// Get field key for validation (use json tag by default)
-		fieldKey := getFieldKey(field, FormatJSON)
-		if fieldKey == "-" {
-			continue
+		// Recursively validate nested structs, directly or through pointers, slices, arrays, and maps
+		if holdsStructs(field.typ) {
+			errors.Add(validateNested(ctx, fieldVal, field.name, depth+1, groups))
 		}
 
-		// Recursively validate nested structs
-		if fieldVal.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{}) {
-			if err := validateStructValueDepth(fieldVal, fieldVal.Type(), depth+1); err != nil {
+		// Apply validation rules (including cross-field validators)
+		if rules := field.rulesFor(groups); len(rules) > 0 {
+			if err := validateValueContext(ctx, field.name, fieldVal.Interface(), rules, val); err != nil {
 				errors.Add(err)
 			}
 		}
 
-		// Recursively validate pointer to struct
-		if fieldVal.Kind() == reflect.Ptr && !fieldVal.IsNil() {
-			elem := fieldVal.Elem()
-			if elem.Kind() == reflect.Struct && elem.Type() != reflect.TypeOf(time.Time{}) {
-				if err := validateStructValueDepth(elem, elem.Type(), depth+1); err != nil {
-					errors.Add(err)
-				}
-			}
+		if failFast(ctx, errors) {
+			return errors.AsError()
 		}
+	}
 
-		// Apply validation rules (including cross-field validators)
-		if err := validateFieldValueWithStruct(field.Name, fieldKey, fieldVal.Interface(), validation, val); err != nil {
-			errors.Add(err)
-		}
+	// Object-level invariants run only once every field is valid
+	if !errors.hasFatal() {
+		errors.Add(runSelfValidation(val))
 	}
 
-	if errors.HasErrors() {
+	return errors.AsError()
+}
+
+// validateNested validates the structs held by v, prefixing error paths with path and,
+// for slice, array, and map elements, the element's index or key
+func validateNested(ctx context.Context, v reflect.Value, path string, depth int, groups []string) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return validateNested(ctx, v.Elem(), path, depth, groups)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			return nil
+		}
+		if err := validateStructValueDepth(ctx, addressable(v), v.Type(), depth, groups); err != nil {
+			return prefixFieldPaths(err, path)
+		}
+	case reflect.Slice, reflect.Array:
+		var errors ErrorList
+		for i := 0; i < v.Len(); i++ {
+			errors.Add(validateNested(ctx, v.Index(i), fmt.Sprintf("%s[%d]", path, i), depth, groups))
+			if failFast(ctx, errors) {
+				break
+			}
+		}
+		return errors.AsError()
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		var errors ErrorList
+		for _, key := range keys {
+			errors.Add(validateNested(ctx, v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), depth, groups))
+			if failFast(ctx, errors) {
+				break
+			}
+		}
 		return errors.AsError()
 	}
-
 	return nil
 }
 
+// validateParsed validates a value decoded by the standard-unmarshal fast path as Validate
+// does. Values holding no structs with rules are not walked.
+func validateParsed(ctx context.Context, val reflect.Value) error {
+	return failOnErrors(validateTopLevel(ctx, val, nil))
+}
+
+// holdsStructs reports whether values of typ can contain structs to validate: typ is a
+// struct other than time.Time, or a pointer, slice, array, or map leading to one
+func holdsStructs(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && typ != reflect.TypeOf(time.Time{})
+}
+
 // parseIntoSlice handles parsing of array/slice data into slice/array types
-func parseIntoSlice[T any](data interface{}, resultType reflect.Type, format Format) (T, error) {
+func parseIntoSlice[T any](ctx context.Context, data interface{}, resultType reflect.Type, format Format) (T, error) {
 	var zero T
 	var errors ErrorList
 
@@ -507,8 +962,11 @@ func parseIntoSlice[T any](data interface{}, resultType reflect.Type, format For
 
 		for i, item := range dataSlice {
 			elemValue := slice.Index(i)
-			if err := setFieldValue(elemValue, item, fmt.Sprintf("[%d]", i), format); err != nil {
+			if err := setFieldValue(ctx, elemValue, item, fmt.Sprintf("[%d]", i), format); err != nil {
 				errors.Add(err)
+				if failFast(ctx, errors) {
+					break
+				}
 			}
 		}
 
@@ -529,8 +987,11 @@ func parseIntoSlice[T any](data interface{}, resultType reflect.Type, format For
 
 		for i, item := range dataSlice {
 			elemValue := array.Index(i)
-			if err := setFieldValue(elemValue, item, fmt.Sprintf("[%d]", i), format); err != nil {
+			if err := setFieldValue(ctx, elemValue, item, fmt.Sprintf("[%d]", i), format); err != nil {
 				errors.Add(err)
+				if failFast(ctx, errors) {
+					break
+				}
 			}
 		}
 
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"sort"
+)
+
+// FieldPresence records which keys appeared in the input and which were explicitly null.
+// It lets callers distinguish a missing key from a key sent as null, which both produce a
+// nil pointer after parsing. This is the building block for PATCH-style partial updates.
+//
+// Paths use the data keys from the input (json/yaml names, not Go field names), joined
+// with "." for nested objects, e.g. "address.city". Arrays are not descended into.
+type FieldPresence struct {
+	keys map[string]bool // path -> value was null
+}
+
+// Has reports whether the key at path was present in the input, including as null.
+func (p *FieldPresence) Has(path string) bool {
+	if p == nil {
+		return false
+	}
+	_, ok := p.keys[path]
+	return ok
+}
+
+// IsNull reports whether the key at path was present in the input with a null value.
+func (p *FieldPresence) IsNull(path string) bool {
+	if p == nil {
+		return false
+	}
+	return p.keys[path]
+}
+
+// Keys returns all present paths in sorted order.
+func (p *FieldPresence) Keys() []string {
+	if p == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(p.keys))
+	for key := range p.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ParseIntoWithPresence parses raw data like ParseInto and additionally reports which keys
+// were present in the input.
+//
+// Example:
+//
+//	type UserPatch struct {
+//	    Name  *string `json:"name"`
+//	    Email *string `json:"email"`
+//	}
+//
+//	patch, presence, err := model.ParseIntoWithPresence[UserPatch](data)
+//	if presence.IsNull("email") {
+//	    // client explicitly cleared the email
+//	} else if !presence.Has("email") {
+//	    // leave email unchanged
+//	}
+func ParseIntoWithPresence[T any](raw []byte) (T, *FieldPresence, error) {
+	var zero T
+
+	result, err := ParseInto[T](raw)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	data, err := GetParser(DetectFormat(raw)).Parse(raw)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	presence := &FieldPresence{keys: make(map[string]bool)}
+	presence.collect("", data)
+	return result, presence, nil
+}
+
+// collect records the keys of a decoded object, recursing into nested objects
+func (p *FieldPresence) collect(prefix string, data interface{}) {
+	obj, ok := data.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for key, value := range obj {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		p.keys[path] = value == nil
+		p.collect(path, value)
+	}
+}
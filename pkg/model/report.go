@@ -0,0 +1,148 @@
+package model
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrorEncoder serializes a structured error report for ErrorList.Marshal.
+// Register one with RegisterErrorEncoder to add a format.
+type ErrorEncoder func(report *StructuredErrorReport) ([]byte, error)
+
+var (
+	errorEncodersMu sync.RWMutex
+	errorEncoders   = map[string]ErrorEncoder{
+		"json": func(report *StructuredErrorReport) ([]byte, error) { return json.Marshal(report) },
+		"xml":  marshalReportXML,
+	}
+)
+
+// RegisterErrorEncoder adds a named error report format for ErrorList.Marshal, replacing
+// any existing encoder with the same name. Built-in formats are json and xml. It is safe
+// to call concurrently with Marshal.
+//
+// Example:
+//
+//	model.RegisterErrorEncoder("yaml", func(report *model.StructuredErrorReport) ([]byte, error) {
+//	    return yaml.Marshal(report)
+//	})
+//
+//	body, err := errs.Marshal("yaml")
+func RegisterErrorEncoder(name string, encoder ErrorEncoder) {
+	errorEncodersMu.Lock()
+	defer errorEncodersMu.Unlock()
+	errorEncoders[name] = encoder
+}
+
+// lookupErrorEncoder returns the error encoder registered under name
+func lookupErrorEncoder(name string) (ErrorEncoder, bool) {
+	errorEncodersMu.RLock()
+	defer errorEncodersMu.RUnlock()
+	encoder, ok := errorEncoders[name]
+	return encoder, ok
+}
+
+// Marshal serializes the ErrorList's structured report in the named format: json, xml,
+// or one added with RegisterErrorEncoder.
+func (el ErrorList) Marshal(format string) ([]byte, error) {
+	encoder, ok := lookupErrorEncoder(format)
+	if !ok {
+		return nil, fmt.Errorf("unknown error report format %q", format)
+	}
+	return encoder(el.ToStructuredReport())
+}
+
+// ToXML converts an ErrorList to XML for clients that expect XML error envelopes.
+// Values and details are written as text; details are sorted by name.
+//
+// Example output:
+//
+//	<validation_errors count="1">
+//	  <error field="Age" field_path="Age">
+//	    <value>15</value>
+//	    <validation_error rule="min">
+//	      <message>value must be at least 18</message>
+//	      <detail name="min">18</detail>
+//	    </validation_error>
+//	  </error>
+//	</validation_errors>
+func (el ErrorList) ToXML() ([]byte, error) {
+	return marshalReportXML(el.ToStructuredReport())
+}
+
+// xmlErrorReport mirrors StructuredErrorReport with XML mapping; encoding/xml cannot
+// marshal the interface{} values and detail maps of the JSON types directly
+type xmlErrorReport struct {
+	XMLName  xml.Name        `xml:"validation_errors"`
+	Count    int             `xml:"count,attr"`
+	Errors   []xmlFieldError `xml:"error"`
+	Warnings []xmlFieldError `xml:"warning"`
+}
+
+// xmlFieldError mirrors FieldError
+type xmlFieldError struct {
+	Field     string               `xml:"field,attr"`
+	FieldPath string               `xml:"field_path,attr"`
+	Value     *string              `xml:"value"`
+	Errors    []xmlValidationError `xml:"validation_error"`
+}
+
+// xmlValidationError mirrors ValidationErrorInfo
+type xmlValidationError struct {
+	Rule    string      `xml:"rule,attr"`
+	Message string      `xml:"message"`
+	Details []xmlDetail `xml:"detail"`
+}
+
+// xmlDetail is one entry of ValidationErrorInfo.Details
+type xmlDetail struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// marshalReportXML is the built-in xml error encoder
+func marshalReportXML(report *StructuredErrorReport) ([]byte, error) {
+	return xml.MarshalIndent(xmlErrorReport{
+		Count:    report.Count,
+		Errors:   xmlFieldErrors(report.Errors),
+		Warnings: xmlFieldErrors(report.Warnings),
+	}, "", "  ")
+}
+
+// xmlFieldErrors converts FieldError entries to their XML form
+func xmlFieldErrors(fieldErrors []FieldError) []xmlFieldError {
+	converted := make([]xmlFieldError, len(fieldErrors))
+	for i, fieldErr := range fieldErrors {
+		var value *string
+		if fieldErr.Value != nil {
+			text := fmt.Sprint(fieldErr.Value)
+			value = &text
+		}
+
+		infos := make([]xmlValidationError, len(fieldErr.Errors))
+		for j, info := range fieldErr.Errors {
+			names := make([]string, 0, len(info.Details))
+			for name := range info.Details {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			details := make([]xmlDetail, len(names))
+			for k, name := range names {
+				details[k] = xmlDetail{Name: name, Value: fmt.Sprint(info.Details[name])}
+			}
+			infos[j] = xmlValidationError{Rule: info.Rule, Message: info.Message, Details: details}
+		}
+
+		converted[i] = xmlFieldError{
+			Field:     fieldErr.Field,
+			FieldPath: fieldErr.FieldPath,
+			Value:     value,
+			Errors:    infos,
+		}
+	}
+	return converted
+}
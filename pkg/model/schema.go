@@ -0,0 +1,558 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fieldSchema holds precomputed metadata for a single parseable struct field.
+type fieldSchema struct {
+	index   []int            // Index path of the field (longer than one for promoted embedded fields)
+	name    string           // Go field name
+	key     string           // Data key for the field in the schema's format
+	aliases []string         // Alternate data keys from the aliases tag, tried in order after key
+	typ     reflect.Type     // Coercion target type
+	rules   []ValidationRule // Validation rules that apply to this field
+	groups  []string         // Validation groups the rules belong to; empty means always validated
+
+	defaultValue  string   // Value from the default tag, coerced like input when the key is absent
+	hasDefault    bool     // Whether the field has a default tag
+	transforms    []string // Transform names from the transform tag, applied after coercion
+	noCoerce      bool     // Whether the field is tagged coerce:"false" and accepts only exact input types
+	discriminator string   // Input key from the discriminator tag naming the registered variant of an interface field
+	keyTagged     bool     // Whether the key comes from the key tag, which standard unmarshal ignores
+	timeZone      string   // Location name from the tz tag, applied to time.Time values after coercion
+}
+
+// structSchema is the precomputed parse/validate plan for a struct type in a given format.
+// It lets the parse and validation loops iterate a flat slice instead of reflecting
+// over every field and re-deriving tag keys on each call.
+type structSchema struct {
+	fields []fieldSchema
+	flat   bool // Whether every field is a direct primitive without cross-field rules (see isFlat)
+}
+
+// schemaCacheKey identifies a cached schema by struct type and data format
+type schemaCacheKey struct {
+	typ    reflect.Type
+	format Format
+}
+
+// schemaCache stores structSchema values keyed by schemaCacheKey
+var schemaCache sync.Map // map[schemaCacheKey]*structSchema
+
+// getStructSchema returns the cached schema for a struct type, building it on first use.
+// Unexported fields and fields tagged "-" are omitted. Fields of untagged embedded
+// structs are promoted to the parent level following encoding/json semantics.
+func getStructSchema(typ reflect.Type, format Format) *structSchema {
+	cacheKey := schemaCacheKey{typ: typ, format: format}
+	if cached, ok := schemaCache.Load(cacheKey); ok {
+		return cached.(*structSchema)
+	}
+
+	fields := collectSchemaFields(typ, format)
+	schema := &structSchema{
+		fields: fields,
+		flat:   isFlat(fields),
+	}
+
+	actual, _ := schemaCache.LoadOrStore(cacheKey, schema)
+	return actual.(*structSchema)
+}
+
+// isFlat reports whether fields are all declared directly on the struct, have string,
+// bool, or numeric kinds, and carry no cross-field rules. Such a struct can be validated
+// field by field as it is coerced, since no rule reads another field or a nested struct.
+func isFlat(fields []fieldSchema) bool {
+	for i := range fields {
+		field := &fields[i]
+		if len(field.index) != 1 || !isPrimitiveKind(field.typ.Kind()) {
+			return false
+		}
+		for _, rule := range field.rules {
+			if _, ok := rule.Validator.(*CrossFieldValidator); ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isPrimitiveKind reports whether kind is a string, bool, or numeric kind
+func isPrimitiveKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// schemaCandidate is a field considered while resolving promoted embedded fields
+type schemaCandidate struct {
+	field  fieldSchema
+	depth  int
+	tagged bool
+}
+
+// collectSchemaFields walks a struct type and its embedded structs breadth-first.
+// When several fields map to the same key, the shallowest wins; among fields at the
+// same depth a single tagged field wins, otherwise all are dropped (as encoding/json does).
+func collectSchemaFields(typ reflect.Type, format Format) []fieldSchema {
+	type level struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	var candidates []schemaCandidate
+	visited := map[reflect.Type]bool{}
+	current := []level{{typ: typ}}
+
+	for depth := 0; len(current) > 0; depth++ {
+		var next []level
+
+		for _, lvl := range current {
+			if visited[lvl.typ] {
+				continue
+			}
+			visited[lvl.typ] = true
+
+			validation := ParseValidationTags(lvl.typ)
+
+			for i := 0; i < lvl.typ.NumField(); i++ {
+				field := lvl.typ.Field(i)
+				index := append(append([]int{}, lvl.index...), i)
+
+				if field.Anonymous {
+					embedded := field.Type
+					if embedded.Kind() == reflect.Ptr {
+						embedded = embedded.Elem()
+					}
+
+					// Untagged embedded structs have their fields promoted
+					if embedded.Kind() == reflect.Struct && !hasFieldKeyTag(field, format) {
+						if getFieldKey(field, format) == "-" {
+							continue
+						}
+						// Pointers to unexported structs cannot be allocated via reflection
+						if field.Type.Kind() == reflect.Ptr && !field.IsExported() {
+							continue
+						}
+						next = append(next, level{typ: embedded, index: index})
+						continue
+					}
+				}
+
+				// Skip unexported fields
+				if !field.IsExported() {
+					continue
+				}
+
+				// Get field key from appropriate tag (json or yaml), fallback to field name
+				key := getFieldKey(field, format)
+				if key == "-" {
+					continue // Skip fields with tag:"-"
+				}
+
+				rules, groups := findFieldRules(validation, field.Name, key)
+				defaultValue, hasDefault := field.Tag.Lookup("default")
+				candidates = append(candidates, schemaCandidate{
+					field: fieldSchema{
+						index:         index,
+						name:          field.Name,
+						key:           key,
+						typ:           field.Type,
+						rules:         rules,
+						groups:        groups,
+						defaultValue:  defaultValue,
+						hasDefault:    hasDefault,
+						transforms:    splitTagList(field.Tag.Get("transform")),
+						aliases:       splitTagList(field.Tag.Get("aliases")),
+						noCoerce:      field.Tag.Get("coerce") == "false",
+						discriminator: field.Tag.Get("discriminator"),
+						keyTagged:     usesKeyTag(field),
+						timeZone:      field.Tag.Get("tz"),
+					},
+					depth:  depth,
+					tagged: hasFieldKeyTag(field, format),
+				})
+			}
+		}
+
+		current = next
+	}
+
+	return dominantFields(candidates)
+}
+
+// dominantFields resolves key conflicts among candidates and returns the
+// surviving fields in struct declaration order.
+func dominantFields(candidates []schemaCandidate) []fieldSchema {
+	byKey := make(map[string][]schemaCandidate, len(candidates))
+	for _, c := range candidates {
+		byKey[c.field.key] = append(byKey[c.field.key], c)
+	}
+
+	fields := make([]fieldSchema, 0, len(candidates))
+	for _, c := range candidates {
+		group := byKey[c.field.key]
+		if dominant, ok := dominantCandidate(group); ok && sameIndex(dominant.field.index, c.field.index) {
+			fields = append(fields, c.field)
+		}
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return indexLess(fields[i].index, fields[j].index)
+	})
+	return fields
+}
+
+// dominantCandidate picks the winning candidate for a single key, if any
+func dominantCandidate(group []schemaCandidate) (schemaCandidate, bool) {
+	if len(group) == 1 {
+		return group[0], true
+	}
+
+	minDepth := group[0].depth
+	for _, c := range group[1:] {
+		if c.depth < minDepth {
+			minDepth = c.depth
+		}
+	}
+
+	var shallowest []schemaCandidate
+	for _, c := range group {
+		if c.depth == minDepth {
+			shallowest = append(shallowest, c)
+		}
+	}
+	if len(shallowest) == 1 {
+		return shallowest[0], true
+	}
+
+	var tagged []schemaCandidate
+	for _, c := range shallowest {
+		if c.tagged {
+			tagged = append(tagged, c)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+
+	return schemaCandidate{}, false
+}
+
+func sameIndex(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func indexLess(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// tagFieldKeys returns the input key for each schema field under an alternative tag such
+// as csv or form: the tag's name if present, otherwise the field's json key. A tag of "-"
+// yields an empty key, which callers never match.
+func tagFieldKeys(typ reflect.Type, schema *structSchema, tagName string) []string {
+	keys := make([]string, len(schema.fields))
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		keys[i] = field.key
+
+		tag := typ.FieldByIndex(field.index).Tag.Get(tagName)
+		if idx := strings.IndexByte(tag, ','); idx >= 0 {
+			tag = tag[:idx]
+		}
+		switch tag {
+		case "":
+		case "-":
+			keys[i] = ""
+		default:
+			keys[i] = tag
+		}
+	}
+	return keys
+}
+
+// lookup returns the input value for the field from a decoded object, trying the key
+// and then each alias in order; the first present wins. Exact matches always win; when
+// case-insensitive keys are enabled, a key differing only in case is used instead,
+// taking the first in sorted order if there are several.
+func (f *fieldSchema) lookup(data map[string]interface{}) (interface{}, bool) {
+	if value, ok := data[f.key]; ok {
+		return value, true
+	}
+	for _, alias := range f.aliases {
+		if value, ok := data[alias]; ok {
+			return value, true
+		}
+	}
+	if !GetCaseInsensitiveKeys() {
+		return nil, false
+	}
+
+	if value, ok := lookupFold(data, f.key); ok {
+		return value, true
+	}
+	for _, alias := range f.aliases {
+		if value, ok := lookupFold(data, alias); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// lookupFold returns the value whose key equals name ignoring case, taking the first
+// matching key in sorted order
+func lookupFold[V any](data map[string]V, name string) (V, bool) {
+	match := ""
+	found := false
+	for key := range data {
+		if strings.EqualFold(key, name) && (!found || key < match) {
+			match, found = key, true
+		}
+	}
+	if !found {
+		var zero V
+		return zero, false
+	}
+	return data[match], true
+}
+
+// inputContext returns the context for coercing a value looked up from decoded input.
+// For fields tagged coerce:"false" it carries NoCoerce, so the value must already have
+// the field's type; defaults are text and are always coerced.
+func (f *fieldSchema) inputContext(ctx context.Context, present bool) context.Context {
+	// The discriminator tag applies to this field's value only, not to fields of structs
+	// nested below it
+	if f.discriminator != "" || discriminatorFrom(ctx) != "" {
+		ctx = withDiscriminator(ctx, f.discriminator)
+	}
+	if !f.noCoerce || !present {
+		return ctx
+	}
+	opts := coerceOptionsFrom(ctx)
+	opts.NoCoerce = true
+	return withCoerceOptions(ctx, opts)
+}
+
+// set coerces rawValue into the field of structValue and applies the field's transforms
+// and time zone
+func (f *fieldSchema) set(ctx context.Context, structValue reflect.Value, rawValue interface{}, fieldName string, format Format) error {
+	fieldValue := fieldForSet(structValue, f.index)
+	if err := setFieldValue(ctx, fieldValue, rawValue, fieldName, format); err != nil {
+		return err
+	}
+	if len(f.transforms) > 0 {
+		if err := applyTransforms(fieldValue, f.transforms, fieldName); err != nil {
+			return err
+		}
+	}
+	if f.timeZone != "" {
+		return applyTimeZone(fieldValue, f.timeZone, fieldName)
+	}
+	return nil
+}
+
+// fieldForSet returns the settable field at the given index path,
+// allocating nil embedded struct pointers along the way.
+func fieldForSet(structValue reflect.Value, index []int) reflect.Value {
+	if len(index) == 1 {
+		return structValue.Field(index[0])
+	}
+
+	v := structValue
+	for i, idx := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	return v
+}
+
+// fieldForRead returns the field at the given index path. It reports false when
+// an embedded struct pointer along the path is nil.
+func fieldForRead(structValue reflect.Value, index []int) (reflect.Value, bool) {
+	if len(index) == 1 {
+		return structValue.Field(index[0]), true
+	}
+
+	v, err := structValue.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+// findFieldRules returns the validation rules and groups registered for a field,
+// matched by Go field name or data key.
+func findFieldRules(validation *StructValidation, fieldName, key string) ([]ValidationRule, []string) {
+	for _, fieldValidation := range validation.Fields {
+		if fieldValidation.FieldName == fieldName || fieldValidation.JSONKey == key {
+			return fieldValidation.Rules, fieldValidation.Groups
+		}
+	}
+	return nil, nil
+}
+
+// rulesFor returns the field's rules if they apply under the active validation groups.
+// Ungrouped rules always apply; grouped rules apply only when one of their groups is active.
+func (f *fieldSchema) rulesFor(activeGroups []string) []ValidationRule {
+	if len(f.groups) == 0 {
+		return f.rules
+	}
+	for _, group := range f.groups {
+		for _, active := range activeGroups {
+			if group == active {
+				return f.rules
+			}
+		}
+	}
+	return nil
+}
+
+// typeNeedsValidation reports whether a struct type, or any struct reachable through
+// its fields and their elements, declares validation rules or implements SelfValidator.
+// Results are cached in noValidationTypes so Validate can skip types that have nothing
+// to check.
+func typeNeedsValidation(typ reflect.Type) bool {
+	if cached, ok := noValidationTypes.Load(typ); ok {
+		return !cached.(bool)
+	}
+
+	needs := structNeedsValidation(typ, map[reflect.Type]bool{})
+	noValidationTypes.Store(typ, !needs)
+	return needs
+}
+
+// structNeedsValidation is the uncached recursive check behind typeNeedsValidation
+func structNeedsValidation(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	if implementsSelfValidator(typ) {
+		return true
+	}
+
+	for _, field := range getStructSchema(typ, FormatJSON).fields {
+		if len(field.rules) > 0 {
+			return true
+		}
+
+		fieldType := field.typ
+		for fieldType.Kind() == reflect.Ptr || fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array || fieldType.Kind() == reflect.Map {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}) &&
+			structNeedsValidation(fieldType, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeNeedsMapCoercion reports whether typ, or any type reachable through its fields or
+// elements, has a field with a default, transform, aliases, tz, or coerce:"false" tag or
+// a registered coercer. These are applied only by map-based coercion, so such types skip
+// the standard-unmarshal fast path (yaml.v3 would otherwise decode 123 into a string
+// field). Results are cached in mapCoercionTypes.
+func typeNeedsMapCoercion(typ reflect.Type) bool {
+	if typ == nil {
+		return false
+	}
+	if cached, ok := mapCoercionTypes.Load(typ); ok {
+		return cached.(bool)
+	}
+
+	needs := needsMapCoercion(typ, map[reflect.Type]bool{})
+	mapCoercionTypes.Store(typ, needs)
+	return needs
+}
+
+// needsMapCoercion is the uncached recursive check behind typeNeedsMapCoercion
+func needsMapCoercion(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	for {
+		if hasCoercer(typ) {
+			return true
+		}
+		if typ.Kind() == reflect.Interface && typ.NumMethod() > 0 && lookupVariants(typ) != nil {
+			return true
+		}
+		if implementsScannerOnly(typ) {
+			return true
+		}
+		if typ.Kind() != reflect.Ptr && typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array && typ.Kind() != reflect.Map {
+			break
+		}
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || typ == reflect.TypeOf(time.Time{}) || visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	for _, field := range getStructSchema(typ, FormatJSON).fields {
+		if field.hasDefault || len(field.transforms) > 0 || len(field.aliases) > 0 || field.noCoerce ||
+			field.discriminator != "" || field.keyTagged || field.timeZone != "" ||
+			needsMapCoercion(field.typ, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsScannerOnly reports whether typ decodes itself only through sql.Scanner, which
+// standard unmarshal never calls
+func implementsScannerOnly(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Interface {
+		return false
+	}
+	ptrType := reflect.PointerTo(typ)
+	return ptrType.Implements(scannerType) && !ptrType.Implements(jsonUnmarshalerType) &&
+		!ptrType.Implements(yamlUnmarshalerType) && !ptrType.Implements(textUnmarshalerType)
+}
+
+// clearSchemaCache drops all cached schemas so they are rebuilt with fresh validation rules
+func clearSchemaCache() {
+	schemaCache.Range(func(key, value interface{}) bool {
+		schemaCache.Delete(key)
+		return true
+	})
+}
+
+// hasKey reports whether any field of the schema reads key
+func (s *structSchema) hasKey(key string) bool {
+	for i := range s.fields {
+		if s.fields[i].key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TransformFunc normalizes a string value after coercion and before validation.
+// Register one with RegisterTransform to use it in transform tags.
+type TransformFunc func(string) string
+
+var (
+	transformsMu sync.RWMutex
+	transforms   = map[string]TransformFunc{
+		"trim":  strings.TrimSpace,
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+		"title": titleCase,
+	}
+)
+
+// RegisterTransform adds a named transform for use in transform tags, replacing any
+// existing transform with the same name. Built-in transforms are trim, lower, upper,
+// and title. It is safe to call concurrently with parsing.
+//
+// Example:
+//
+//	model.RegisterTransform("digits", func(s string) string {
+//	    return strings.Map(func(r rune) rune {
+//	        if unicode.IsDigit(r) {
+//	            return r
+//	        }
+//	        return -1
+//	    }, s)
+//	})
+//
+//	type Contact struct {
+//	    Email string `json:"email" transform:"trim,lower" validate:"email"`
+//	    Phone string `json:"phone" transform:"digits" validate:"len=10"`
+//	}
+func RegisterTransform(name string, fn TransformFunc) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+	transforms[name] = fn
+}
+
+// lookupTransform returns the transform registered under name
+func lookupTransform(name string) (TransformFunc, bool) {
+	transformsMu.RLock()
+	defer transformsMu.RUnlock()
+	fn, ok := transforms[name]
+	return fn, ok
+}
+
+// splitTagList splits a comma-separated tag such as transform or aliases into its
+// trimmed, non-empty entries, in order
+func splitTagList(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(tag, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// applyTransforms runs the named transforms in order on a string, *string, or []string
+// field. Nil pointers are left alone. Unknown transform names and other field types
+// are reported as parse errors.
+func applyTransforms(fieldValue reflect.Value, names []string, fieldName string) error {
+	funcs := make([]TransformFunc, len(names))
+	for i, name := range names {
+		fn, ok := lookupTransform(name)
+		if !ok {
+			return NewParseError(fieldName, fieldValue.Interface(), fieldValue.Type().String(),
+				fmt.Sprintf("unknown transform %q", name))
+		}
+		funcs[i] = fn
+	}
+
+	target := fieldValue
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			return nil
+		}
+		target = target.Elem()
+	}
+
+	switch {
+	case target.Kind() == reflect.String:
+		target.SetString(runTransforms(target.String(), funcs))
+	case target.Kind() == reflect.Slice && target.Type().Elem().Kind() == reflect.String:
+		for i := 0; i < target.Len(); i++ {
+			elem := target.Index(i)
+			elem.SetString(runTransforms(elem.String(), funcs))
+		}
+	default:
+		return NewParseError(fieldName, fieldValue.Interface(), fieldValue.Type().String(),
+			"transforms apply only to string fields")
+	}
+	return nil
+}
+
+// runTransforms applies funcs to s in order
+func runTransforms(s string, funcs []TransformFunc) string {
+	for _, fn := range funcs {
+		s = fn(s)
+	}
+	return s
+}
+
+// titleCase upper-cases the first letter of each whitespace-separated word
+func titleCase(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	wordStart := true
+	for _, r := range s {
+		if wordStart {
+			b.WriteRune(unicode.ToTitle(r))
+		} else {
+			b.WriteRune(r)
+		}
+		wordStart = unicode.IsSpace(r)
+	}
+	return b.String()
+}
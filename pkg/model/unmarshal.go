@@ -0,0 +1,206 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Unmarshal decodes JSON into v using encoding/json, then applies gopantic's type
+// coercion to any fields that failed strict JSON typing (e.g. "42" into an int field).
+// It does not validate; call Validate afterwards if needed.
+//
+// Unlike ParseInto, decoding is done by the standard library, so custom UnmarshalJSON
+// methods, json.RawMessage, and case-insensitive key matching behave exactly as with
+// json.Unmarshal. Fields whose type implements json.Unmarshaler (other than time.Time)
+// are never coerced; their errors are reported as-is.
+//
+// Use Unmarshal when you already have a decode target, rely on custom JSON unmarshalers,
+// or want to validate separately. Use ParseInto for the one-step parse-and-validate
+// workflow and YAML support.
+//
+// Example:
+//
+//	var user User
+//	if err := model.Unmarshal(data, &user); err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := model.Validate(&user); err != nil {
+//	    log.Fatal(err)
+//	}
+func Unmarshal(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("Unmarshal: non-nil pointer required, got %T", v)
+	}
+
+	if err := checkInputSize(len(data)); err != nil {
+		return err
+	}
+
+	if err := checkRawStructureLimits(data, FormatJSON); err != nil {
+		return err
+	}
+
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+
+	// Only type mismatches are candidates for coercion; syntax errors are returned as-is
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return err
+	}
+
+	return coerceJSONInto(data, rv.Elem(), "")
+}
+
+// coerceJSONInto decodes data into target, falling back to coercion only for the parts
+// that fail strict decoding. Structs, slices, and arrays are handled element by element
+// so that correctly typed values still go through encoding/json.
+func coerceJSONInto(data []byte, target reflect.Value, path string) error {
+	if implementsJSONUnmarshaler(target.Type()) {
+		return decodeStrict(data, target, path)
+	}
+
+	if target.Kind() == reflect.Ptr {
+		if string(data) == "null" {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return coerceJSONInto(data, target.Elem(), path)
+	}
+
+	switch target.Kind() {
+	case reflect.Struct:
+		if target.Type() != timeType {
+			return coerceJSONStruct(data, target, path)
+		}
+	case reflect.Slice, reflect.Array:
+		return coerceJSONSequence(data, target, path)
+	}
+
+	// Scalars and other types: decode generically and coerce
+	var raw interface{}
+	if err := unmarshalJSONNumbers(data, &raw); err != nil {
+		return NewParseError(path, string(data), target.Type().String(), err.Error())
+	}
+	return setFieldValue(context.Background(), target, raw, path, FormatJSON)
+}
+
+// coerceJSONStruct decodes each field of a JSON object independently. Keys are matched
+// as encoding/json matches them: exactly, or else ignoring case.
+func coerceJSONStruct(data []byte, target reflect.Value, path string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return NewParseError(path, string(data), target.Type().String(),
+			fmt.Sprintf("cannot decode %s into struct: %v", data, err))
+	}
+
+	schema := getStructSchema(target.Type(), FormatJSON)
+	var errs ErrorList
+
+	for i := range schema.fields {
+		field := &schema.fields[i]
+		raw, ok := fields[field.key]
+		if !ok {
+			raw, ok = lookupFold(fields, field.key)
+		}
+		if !ok {
+			continue
+		}
+
+		if err := decodeOrCoerce(raw, fieldForSet(target, field.index), joinFieldPath(path, field.name)); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	return errs.AsError()
+}
+
+// coerceJSONSequence decodes each element of a JSON array independently
+func coerceJSONSequence(data []byte, target reflect.Value, path string) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return NewParseError(path, string(data), target.Type().String(),
+			fmt.Sprintf("cannot decode %s into %s: %v", data, target.Kind(), err))
+	}
+
+	if target.Kind() == reflect.Array {
+		if len(items) != target.Len() {
+			return NewParseError(path, string(data), target.Type().String(),
+				fmt.Sprintf("array length mismatch: expected %d, got %d", target.Len(), len(items)))
+		}
+	} else {
+		target.Set(reflect.MakeSlice(target.Type(), len(items), len(items)))
+	}
+
+	var errs ErrorList
+	for i, item := range items {
+		if err := decodeOrCoerce(item, target.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			errs.Add(err)
+		}
+	}
+	return errs.AsError()
+}
+
+// decodeOrCoerce tries strict decoding first and falls back to coercion on type mismatches
+func decodeOrCoerce(data []byte, target reflect.Value, path string) error {
+	fresh := reflect.New(target.Type())
+	err := json.Unmarshal(data, fresh.Interface())
+	if err == nil {
+		target.Set(fresh.Elem())
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) || implementsJSONUnmarshaler(target.Type()) {
+		return NewParseError(path, string(data), target.Type().String(), err.Error())
+	}
+
+	return coerceJSONInto(data, target, path)
+}
+
+// decodeStrict decodes data with encoding/json only, wrapping failures as a ParseError
+func decodeStrict(data []byte, target reflect.Value, path string) error {
+	fresh := reflect.New(target.Type())
+	if err := json.Unmarshal(data, fresh.Interface()); err != nil {
+		return NewParseError(path, string(data), target.Type().String(), err.Error())
+	}
+	target.Set(fresh.Elem())
+	return nil
+}
+
+// implementsJSONUnmarshaler reports whether t (after dereferencing pointers) or a pointer
+// to it implements json.Unmarshaler. time.Time is excluded so that Unix timestamps and
+// other formats can still be coerced.
+func implementsJSONUnmarshaler(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return false
+	}
+	return t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType)
+}
+
+// joinFieldPath appends a field name, or an index such as "[2]", to a dotted field path
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	if name == "" {
+		return path
+	}
+	if strings.HasPrefix(name, "[") {
+		return path + name
+	}
+	return path + "." + name
+}
@@ -1,11 +1,14 @@
 package model
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Validator represents a validation rule that can be applied to a field.
@@ -23,6 +26,7 @@ type ValidationRule struct {
 	Name       string                 // Name of the validator (e.g., "min")
 	Validator  Validator              // The validator instance
 	Parameters map[string]interface{} // Parameters for the validator (e.g., {"value": 5})
+	Severity   Severity               // SeverityWarning when the rule is followed by warn in the tag
 }
 
 // FieldValidation contains all validation rules for a single struct field.
@@ -31,6 +35,7 @@ type FieldValidation struct {
 	FieldName string           // Name of the struct field
 	JSONKey   string           // JSON key for this field
 	Rules     []ValidationRule // List of validation rules to apply
+	Groups    []string         // Validation groups from the groups tag; empty means always validated
 }
 
 // StructValidation contains validation information for an entire struct.
@@ -39,6 +44,44 @@ type StructValidation struct {
 	Fields []FieldValidation // Validation rules for each field
 }
 
+// SelfValidator is implemented by structs that check invariants spanning the whole object.
+// ParseInto and Validate call ValidateStruct after all field rules pass, on both value and
+// pointer receivers, including for nested structs. A returned ErrorList is merged into the
+// parse errors.
+//
+// Example:
+//
+//	func (r DateRange) ValidateStruct() error {
+//	    if r.End.Before(r.Start) {
+//	        return model.NewValidationError("End", r.End, "range", "end must not be before start")
+//	    }
+//	    return nil
+//	}
+type SelfValidator interface {
+	ValidateStruct() error
+}
+
+var selfValidatorType = reflect.TypeOf((*SelfValidator)(nil)).Elem()
+
+// implementsSelfValidator reports whether typ or a pointer to it implements SelfValidator
+func implementsSelfValidator(typ reflect.Type) bool {
+	return typ.Implements(selfValidatorType) || reflect.PointerTo(typ).Implements(selfValidatorType)
+}
+
+// runSelfValidation calls ValidateStruct on a struct value if its type implements
+// SelfValidator, using the pointer receiver when the value is addressable
+func runSelfValidation(val reflect.Value) error {
+	if val.CanAddr() {
+		if sv, ok := val.Addr().Interface().(SelfValidator); ok {
+			return sv.ValidateStruct()
+		}
+	}
+	if sv, ok := val.Interface().(SelfValidator); ok {
+		return sv.ValidateStruct()
+	}
+	return nil
+}
+
 // ValidatorFunc represents a custom validation function for field-level validation.
 // Use RegisterGlobalFunc to register custom validators that can be used in struct tags.
 type ValidatorFunc func(fieldName string, value interface{}, params map[string]interface{}) error
@@ -47,21 +90,33 @@ type ValidatorFunc func(fieldName string, value interface{}, params map[string]i
 // Use RegisterGlobalCrossFieldFunc for validators that need to access other fields for validation.
 type CrossFieldValidatorFunc func(fieldName string, fieldValue interface{}, structValue reflect.Value, params map[string]interface{}) error
 
+// ContextValidatorFunc represents a validation function that receives the request context,
+// for validators that call external services or need deadlines, auth, or database handles.
+// Use RegisterGlobalContextFunc to register it and ParseIntoContext or ValidateWithContext
+// to supply the context; other entry points pass context.Background().
+type ContextValidatorFunc func(ctx context.Context, fieldName string, value interface{}, params map[string]interface{}) error
+
 // ValidatorRegistry manages the collection of available validators.
 // Provides registration and lookup capabilities for built-in and custom validators.
 type ValidatorRegistry struct {
 	validators      map[string]func(params map[string]interface{}) Validator
 	customFuncs     map[string]ValidatorFunc
 	crossFieldFuncs map[string]CrossFieldValidatorFunc
+	contextFuncs    map[string]ContextValidatorFunc
 }
 
 // NewValidatorRegistry creates a new validator registry with built-in validators.
-// Includes required, min, max, email, length, alpha, and alphanum validators.
+// Includes required, min, max, email, length, len, alpha, alphanum, alphaunicode,
+// alphanumunicode, numeric, number, ip, ipv4, ipv6, cidr, port, regex, contains,
+// startswith, endswith, future, and past validators, plus the required_if, required_unless,
+// excluded_with, mutually_exclusive, gtfield, gtefield, ltfield, and ltefield cross-field
+// validators.
 func NewValidatorRegistry() *ValidatorRegistry {
 	registry := &ValidatorRegistry{
 		validators:      make(map[string]func(params map[string]interface{}) Validator),
 		customFuncs:     make(map[string]ValidatorFunc),
 		crossFieldFuncs: make(map[string]CrossFieldValidatorFunc),
+		contextFuncs:    make(map[string]ContextValidatorFunc),
 	}
 
 	// Register built-in validators
@@ -100,6 +155,15 @@ func NewValidatorRegistry() *ValidatorRegistry {
 		return &LengthValidator{Length: 0} // Default length
 	})
 
+	registry.Register("len", func(params map[string]interface{}) Validator {
+		if val, ok := params["value"]; ok {
+			if lengthVal, err := toInt(val); err == nil {
+				return &LengthValidator{Length: lengthVal, rule: "len"}
+			}
+		}
+		return &LengthValidator{rule: "len"}
+	})
+
 	registry.Register("alpha", func(params map[string]interface{}) Validator {
 		return &AlphaValidator{}
 	})
@@ -108,6 +172,75 @@ func NewValidatorRegistry() *ValidatorRegistry {
 		return &AlphanumValidator{}
 	})
 
+	registry.Register("alphaunicode", func(params map[string]interface{}) Validator {
+		return &AlphaUnicodeValidator{}
+	})
+
+	registry.Register("alphanumunicode", func(params map[string]interface{}) Validator {
+		return &AlphanumUnicodeValidator{}
+	})
+
+	registry.Register("numeric", func(params map[string]interface{}) Validator {
+		return &NumericValidator{}
+	})
+
+	registry.Register("number", func(params map[string]interface{}) Validator {
+		return &NumberValidator{}
+	})
+
+	registry.Register("ip", func(params map[string]interface{}) Validator {
+		return &IPValidator{}
+	})
+
+	registry.Register("ipv4", func(params map[string]interface{}) Validator {
+		return &IPValidator{Version: 4}
+	})
+
+	registry.Register("ipv6", func(params map[string]interface{}) Validator {
+		return &IPValidator{Version: 6}
+	})
+
+	registry.Register("cidr", func(params map[string]interface{}) Validator {
+		return &CIDRValidator{}
+	})
+
+	registry.Register("port", func(params map[string]interface{}) Validator {
+		return &PortValidator{}
+	})
+
+	registry.Register("regex", func(params map[string]interface{}) Validator {
+		return &RegexValidator{Pattern: stringParam(params)}
+	})
+
+	registry.Register("contains", func(params map[string]interface{}) Validator {
+		return &ContainsValidator{Substring: stringParam(params)}
+	})
+
+	registry.Register("startswith", func(params map[string]interface{}) Validator {
+		return &StartsWithValidator{Prefix: stringParam(params)}
+	})
+
+	registry.Register("endswith", func(params map[string]interface{}) Validator {
+		return &EndsWithValidator{Suffix: stringParam(params)}
+	})
+
+	registry.Register("future", func(params map[string]interface{}) Validator {
+		return &FutureValidator{Tolerance: durationParam(params)}
+	})
+
+	registry.Register("past", func(params map[string]interface{}) Validator {
+		return &PastValidator{Tolerance: durationParam(params)}
+	})
+
+	// Register built-in cross-field validators
+	registry.RegisterCrossFieldFunc("required_if", requiredIfValidator)
+	registry.RegisterCrossFieldFunc("required_unless", requiredUnlessValidator)
+	registry.RegisterCrossFieldFunc("excluded_with", excludedWithValidator)
+	registry.RegisterCrossFieldFunc("mutually_exclusive", mutuallyExclusiveValidator)
+	for _, comparison := range fieldComparisons {
+		registry.RegisterCrossFieldFunc(comparison.rule, comparison.crossFieldFunc())
+	}
+
 	return registry
 }
 
@@ -165,6 +298,56 @@ func (r *ValidatorRegistry) RegisterCrossFieldFunc(name string, validatorFunc Cr
 	r.crossFieldFuncs[name] = validatorFunc
 }
 
+// RegisterContextFunc adds a context-aware validation function to the registry.
+// The function receives the context passed to ParseIntoContext or ValidateWithContext,
+// so slow checks can honor cancellation and deadlines.
+//
+// Example usage:
+//
+//	registry.RegisterContextFunc("unique_email", func(ctx context.Context, fieldName string, value interface{}, params map[string]interface{}) error {
+//	    taken, err := db.EmailExists(ctx, value.(string))
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if taken {
+//	        return model.NewValidationError(fieldName, value, "unique_email", "email is already registered")
+//	    }
+//	    return nil
+//	})
+func (r *ValidatorRegistry) RegisterContextFunc(name string, validatorFunc ContextValidatorFunc) {
+	r.contextFuncs[name] = validatorFunc
+}
+
+// Override replaces every validator registered under name (built-in, custom, cross-field,
+// or context-aware) with validatorFunc, so it applies regardless of Create's precedence.
+// Use it to swap a built-in such as email for an organization-specific implementation.
+//
+// Note: Call ClearValidationCache() afterwards if types using name have already been parsed.
+//
+// Example usage:
+//
+//	model.GetDefaultRegistry().Override("email", func(fieldName string, value interface{}, params map[string]interface{}) error {
+//	    if s, _ := value.(string); s != "" && !strings.HasSuffix(s, "@example.com") {
+//	        return model.NewValidationError(fieldName, value, "email", "must be a company address")
+//	    }
+//	    return nil
+//	})
+func (r *ValidatorRegistry) Override(name string, validatorFunc ValidatorFunc) {
+	r.Disable(name)
+	r.customFuncs[name] = validatorFunc
+}
+
+// Disable removes every validator registered under name. Struct tags that reference a
+// disabled validator are then ignored, as with any unknown validator name.
+//
+// Note: Call ClearValidationCache() afterwards if types using name have already been parsed.
+func (r *ValidatorRegistry) Disable(name string) {
+	delete(r.validators, name)
+	delete(r.customFuncs, name)
+	delete(r.crossFieldFuncs, name)
+	delete(r.contextFuncs, name)
+}
+
 // CustomFuncValidator wraps a ValidatorFunc to implement the Validator interface
 type CustomFuncValidator struct {
 	name   string
@@ -205,7 +388,32 @@ func (v *CrossFieldValidator) ValidateWithStruct(fieldName string, fieldValue in
 	return v.fn(fieldName, fieldValue, structValue, v.params)
 }
 
-// Create creates a validator instance from the registry
+// ContextValidator wraps a ContextValidatorFunc to implement the Validator interface
+type ContextValidator struct {
+	name   string
+	fn     ContextValidatorFunc
+	params map[string]interface{}
+}
+
+// Name returns the name of the context-aware validator
+func (v *ContextValidator) Name() string {
+	return v.name
+}
+
+// Validate executes the validation function with context.Background()
+func (v *ContextValidator) Validate(fieldName string, value interface{}) error {
+	return v.fn(context.Background(), fieldName, value, v.params)
+}
+
+// ValidateContext executes the validation function with the given context
+func (v *ContextValidator) ValidateContext(ctx context.Context, fieldName string, value interface{}) error {
+	return v.fn(ctx, fieldName, value, v.params)
+}
+
+// Create creates a validator instance from the registry.
+// When several kinds of validator share a name, cross-field functions take precedence,
+// then context-aware functions, then custom functions, then built-in validators.
+// Use Override to replace a name regardless of precedence. Returns nil for unknown names.
 func (r *ValidatorRegistry) Create(name string, params map[string]interface{}) Validator {
 	// Check cross-field functions first
 	if crossFieldFunc, exists := r.crossFieldFuncs[name]; exists {
@@ -216,6 +424,15 @@ func (r *ValidatorRegistry) Create(name string, params map[string]interface{}) V
 		}
 	}
 
+	// Check context-aware functions next
+	if contextFunc, exists := r.contextFuncs[name]; exists {
+		return &ContextValidator{
+			name:   name,
+			fn:     contextFunc,
+			params: params,
+		}
+	}
+
 	// Check custom functions next
 	if customFunc, exists := r.customFuncs[name]; exists {
 		return &CustomFuncValidator{
@@ -233,8 +450,14 @@ func (r *ValidatorRegistry) Create(name string, params map[string]interface{}) V
 	return nil // Unknown validator
 }
 
-// Global validator registry instance
-var defaultRegistry = NewValidatorRegistry()
+// Global validator registry instance.
+// Initialized in init() because built-in cross-field validators reach back
+// into coercion, which in turn looks up the default registry.
+var defaultRegistry *ValidatorRegistry
+
+func init() {
+	defaultRegistry = NewValidatorRegistry()
+}
 
 // Global cache for validation metadata by type
 var validationCache sync.Map
@@ -330,6 +553,15 @@ func RegisterGlobalCrossFieldFunc(name string, validatorFunc CrossFieldValidator
 	defaultRegistry.RegisterCrossFieldFunc(name, validatorFunc)
 }
 
+// RegisterGlobalContextFunc is a convenience function to register a context-aware validation
+// function to the default global registry. See RegisterContextFunc.
+//
+// Note: If you register custom validators after types have been parsed, you should call
+// ClearValidationCache() to ensure new validators are used for all subsequent parses.
+func RegisterGlobalContextFunc(name string, validatorFunc ContextValidatorFunc) {
+	defaultRegistry.RegisterContextFunc(name, validatorFunc)
+}
+
 // ClearValidationCache clears the cached validation metadata for all types.
 // This is useful when you register new custom validators and want to ensure
 // they are applied to types that have already been parsed.
@@ -339,6 +571,11 @@ func ClearValidationCache() {
 		validationCache.Delete(key)
 		return true
 	})
+	noValidationTypes.Range(func(key, value interface{}) bool {
+		noValidationTypes.Delete(key)
+		return true
+	})
+	clearSchemaCache()
 
 	// Clear cache order tracking
 	cacheOrderMutex.Lock()
@@ -346,9 +583,9 @@ func ClearValidationCache() {
 	cacheOrderMutex.Unlock()
 }
 
-// ListValidators returns a list of all registered validator names (built-in, custom, and cross-field)
+// ListValidators returns a list of all registered validator names (built-in, custom, cross-field, and context-aware)
 func (r *ValidatorRegistry) ListValidators() []string {
-	names := make([]string, 0, len(r.validators)+len(r.customFuncs)+len(r.crossFieldFuncs))
+	names := make([]string, 0, len(r.validators)+len(r.customFuncs)+len(r.crossFieldFuncs)+len(r.contextFuncs))
 
 	// Add built-in validators
 	for name := range r.validators {
@@ -365,6 +602,11 @@ func (r *ValidatorRegistry) ListValidators() []string {
 		names = append(names, name)
 	}
 
+	// Add context-aware validators
+	for name := range r.contextFuncs {
+		names = append(names, name)
+	}
+
 	return names
 }
 
@@ -399,16 +641,18 @@ func storeInValidationCache(structType reflect.Type, validation *StructValidatio
 	cacheOrderMutex.Lock()
 	defer cacheOrderMutex.Unlock()
 
-	// Check if cache is full
-	if len(cacheOrder) >= maxCacheSize {
-		// Remove oldest entry (FIFO)
+	// Another goroutine may have cached this type while we were parsing tags
+	if _, loaded := validationCache.LoadOrStore(structType, validation); loaded {
+		return
+	}
+
+	// Evict oldest entries (FIFO) until within the limit
+	for len(cacheOrder) >= maxCacheSize {
 		oldest := cacheOrder[0]
 		validationCache.Delete(oldest)
 		cacheOrder = cacheOrder[1:]
 	}
 
-	// Add new entry
-	validationCache.Store(structType, validation)
 	cacheOrder = append(cacheOrder, structType)
 }
 
@@ -450,6 +694,7 @@ func parseValidationTagsUncached(structType reflect.Type) *StructValidation {
 				FieldName: field.Name,
 				JSONKey:   jsonKey,
 				Rules:     rules,
+				Groups:    parseGroupsTag(field.Tag.Get("groups")),
 			}
 			validation.Fields = append(validation.Fields, fieldValidation)
 		}
@@ -458,23 +703,46 @@ func parseValidationTagsUncached(structType reflect.Type) *StructValidation {
 	return validation
 }
 
+// parseGroupsTag splits a groups tag such as `groups:"create,update"` into group names
+func parseGroupsTag(tag string) []string {
+	var groups []string
+	for _, group := range strings.Split(tag, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
 // parseValidationRules parses a validation tag string into ValidationRule structs
 // Example: "required,min=5,max=100,email" -> []ValidationRule
 func parseValidationRules(tag string) ([]ValidationRule, error) {
 	rules := make([]ValidationRule, 0)
 	registry := GetDefaultRegistry()
 
-	// Split by comma to get individual rules
-	ruleParts := strings.Split(tag, ",")
+	// Split by comma to get individual rules (commas inside quoted parameters are kept)
+	ruleParts := splitValidationTag(tag)
 
+	// lastAdded tracks whether the previous part produced a rule, so "warn" after an
+	// unknown validator name does not downgrade an earlier rule
+	lastAdded := false
 	for _, part := range ruleParts {
 		part = strings.TrimSpace(part)
 		if part == "" {
 			continue
 		}
 
+		// "warn" downgrades the preceding rule to a non-fatal warning: "max=100,warn"
+		if part == "warn" {
+			if lastAdded {
+				rules[len(rules)-1].Severity = SeverityWarning
+			}
+			lastAdded = false
+			continue
+		}
+
 		// Parse rule name and parameters
-		// Format: "min=5" or "required" or "range=1:10"
+		// Format: "min=5" or "required" or "range=1:10" or "regex='^a,b$'"
 		var ruleName string
 		params := make(map[string]interface{})
 
@@ -483,8 +751,13 @@ func parseValidationRules(tag string) ([]ValidationRule, error) {
 			ruleName = part[:equalPos]
 			paramValue := part[equalPos+1:]
 
-			// Try to parse parameter as number, fallback to string
-			if numVal, err := strconv.ParseFloat(paramValue, 64); err == nil {
+			// Quoted parameters are always strings, with the quotes removed.
+			// "raw" keeps the literal text for validators that take string parameters.
+			params["raw"] = paramValue
+			if unquoted, ok := unquoteTagParam(paramValue); ok {
+				params["value"] = unquoted
+				params["raw"] = unquoted
+			} else if numVal, err := strconv.ParseFloat(paramValue, 64); err == nil {
 				params["value"] = numVal
 			} else if intVal, err := strconv.ParseInt(paramValue, 10, 64); err == nil {
 				params["value"] = intVal
@@ -498,6 +771,7 @@ func parseValidationRules(tag string) ([]ValidationRule, error) {
 
 		// Create validator instance
 		validator := registry.Create(ruleName, params)
+		lastAdded = validator != nil
 		if validator != nil {
 			rule := ValidationRule{
 				Name:       ruleName,
@@ -511,6 +785,42 @@ func parseValidationRules(tag string) ([]ValidationRule, error) {
 	return rules, nil
 }
 
+// splitValidationTag splits a validate tag on commas, ignoring commas inside
+// single-quoted parameters. Inside quotes, two consecutive single quotes produce a literal quote.
+func splitValidationTag(tag string) []string {
+	var parts []string
+	var current strings.Builder
+	inQuotes := false
+
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		switch {
+		case c == '\'' && inQuotes && i+1 < len(tag) && tag[i+1] == '\'':
+			current.WriteString("''")
+			i++
+		case c == '\'':
+			inQuotes = !inQuotes
+			current.WriteByte(c)
+		case c == ',' && !inQuotes:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	return append(parts, current.String())
+}
+
+// unquoteTagParam strips single quotes from a quoted tag parameter such as '^a,b$'.
+// Returns false if the parameter is not quoted.
+func unquoteTagParam(param string) (string, bool) {
+	if len(param) < 2 || param[0] != '\'' || param[len(param)-1] != '\'' {
+		return "", false
+	}
+	return strings.ReplaceAll(param[1:len(param)-1], "''", "'"), true
+}
+
 // ValidateValue applies validation rules to a single value.
 // This function runs all validation rules and aggregates any errors.
 // Use this for simple field validation without cross-field dependencies.
@@ -530,25 +840,84 @@ func ValidateValue(fieldName string, value interface{}, rules []ValidationRule)
 // This function supports both regular and cross-field validators, making it suitable for
 // complex validation scenarios that require access to other fields in the struct.
 func ValidateValueWithStruct(fieldName string, value interface{}, rules []ValidationRule, structValue reflect.Value) error {
+	return validateValueContext(context.Background(), fieldName, value, rules, structValue)
+}
+
+// validateValueContext is ValidateValueWithStruct with a context for context-aware validators
+func validateValueContext(ctx context.Context, fieldName string, value interface{}, rules []ValidationRule, structValue reflect.Value) error {
 	var errors ErrorList
 
 	for _, rule := range rules {
-		// Check if this is a cross-field validator
-		if crossFieldValidator, ok := rule.Validator.(*CrossFieldValidator); ok {
-			if err := crossFieldValidator.ValidateWithStruct(fieldName, value, structValue); err != nil {
-				errors.Add(err)
-			}
-		} else {
-			// Regular validator
-			if err := rule.Validator.Validate(fieldName, value); err != nil {
-				errors.Add(err)
-			}
+		var err error
+		switch validator := rule.Validator.(type) {
+		case *CrossFieldValidator:
+			err = validator.ValidateWithStruct(fieldName, value, structValue)
+		case *ContextValidator:
+			err = validator.ValidateContext(ctx, fieldName, value)
+		default:
+			err = rule.Validator.Validate(fieldName, value)
+		}
+
+		if err != nil && rule.Severity == SeverityWarning {
+			err = asWarning(err, fieldName, value, rule.Name)
+		}
+		errors.Add(err)
+		if failFast(ctx, errors) {
+			break
 		}
 	}
 
 	return errors.AsError()
 }
 
+// asWarning downgrades the errors from a warn-tagged rule to SeverityWarning. Plain
+// errors are wrapped in a ValidationError so they can carry the severity.
+func asWarning(err error, fieldName string, value interface{}, rule string) error {
+	switch e := err.(type) {
+	case *ValidationError:
+		e.Severity = SeverityWarning
+		return e
+	case ErrorList:
+		for i, item := range e {
+			e[i] = asWarning(item, fieldName, value, rule)
+		}
+		return e
+	default:
+		warning := NewValidationError(fieldName, value, rule, err.Error())
+		warning.Severity = SeverityWarning
+		return warning
+	}
+}
+
+// stringParam returns a rule's parameter as literal tag text, e.g. "1.50" rather than
+// the float 1.5 it would otherwise be parsed as.
+func stringParam(params map[string]interface{}) string {
+	if raw, ok := params["raw"].(string); ok {
+		return raw
+	}
+	if val, ok := params["value"]; ok {
+		return fmt.Sprintf("%v", val)
+	}
+	return ""
+}
+
+// durationParam returns a rule's parameter as a duration, written either as a Go
+// duration string ("5m", "1h30m") or as a plain number of seconds. Missing or
+// invalid parameters yield zero.
+func durationParam(params map[string]interface{}) time.Duration {
+	raw := stringParam(params)
+	if raw == "" {
+		return 0
+	}
+	if d, err := time.ParseDuration(raw); err == nil {
+		return d
+	}
+	if secs, err := strconv.ParseFloat(raw, 64); err == nil {
+		return time.Duration(secs * float64(time.Second))
+	}
+	return 0
+}
+
 // toFloat64 converts various numeric types to float64 for validation purposes
 func toFloat64(value interface{}) (float64, error) {
 	switch v := value.(type) {
@@ -2,12 +2,20 @@ package model
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
-// RequiredValidator checks that a field has a non-zero value
+// RequiredValidator checks that a field has a non-zero value. Slices and maps must be
+// non-nil and hold at least one element; false is a valid bool; pointers only need to
+// be non-nil, so a *[]string pointing at an empty slice passes.
 type RequiredValidator struct{}
 
 // Name returns the validator name
@@ -17,52 +25,48 @@ func (v *RequiredValidator) Name() string {
 
 // Validate checks that a field has a non-zero value
 func (v *RequiredValidator) Validate(fieldName string, value interface{}) error {
-	if value == nil {
+	if isEmptyValue(value) {
 		return NewValidationError(fieldName, value, "required", "field is required")
 	}
+	return nil
+}
+
+// isEmptyValue reports whether a value counts as missing for presence checks.
+// Booleans are never considered empty, since false is a valid value.
+func isEmptyValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
 
 	// Check for zero values based on type
 	val := reflect.ValueOf(value)
 	switch val.Kind() {
 	case reflect.String:
-		if val.String() == "" {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.String() == ""
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if val.Int() == 0 {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.Int() == 0
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if val.Uint() == 0 {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.Uint() == 0
 	case reflect.Float32, reflect.Float64:
-		if val.Float() == 0.0 {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.Float() == 0.0
 	case reflect.Bool:
 		// For booleans, false is considered a valid value, so we don't fail
 		// This matches common validation library behavior
-		return nil
+		return false
 	case reflect.Slice, reflect.Array, reflect.Map:
-		if val.Len() == 0 {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.Len() == 0
 	case reflect.Ptr, reflect.Interface:
-		if val.IsNil() {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.IsNil()
 	case reflect.Struct:
 		// Check if struct is a zero value (all fields are zero)
-		if val.IsZero() {
-			return NewValidationError(fieldName, value, "required", "field is required")
-		}
+		return val.IsZero()
 	}
 
-	return nil
+	return false
 }
 
-// MinValidator checks that a numeric value or string length is at least the minimum
+// MinValidator checks that a numeric value or string length is at least the minimum.
+// String length is counted in characters (runes), not bytes.
 type MinValidator struct {
 	Min float64
 }
@@ -88,9 +92,18 @@ func (v *MinValidator) Validate(fieldName string, value interface{}) error {
 		val = val.Elem()
 	}
 
+	// big.Int and big.Float are compared exactly rather than by kind
+	if cmp, ok := compareBigNumber(value, v.Min); ok {
+		if cmp < 0 {
+			return NewValidationError(fieldName, value, "min",
+				fmt.Sprintf("value must be at least %g", v.Min))
+		}
+		return nil
+	}
+
 	switch val.Kind() {
 	case reflect.String:
-		if float64(len(val.String())) < v.Min {
+		if float64(utf8.RuneCountInString(val.String())) < v.Min {
 			return NewValidationError(fieldName, value, "min",
 				fmt.Sprintf("string length must be at least %.0f characters", v.Min))
 		}
@@ -114,6 +127,11 @@ func (v *MinValidator) Validate(fieldName string, value interface{}) error {
 			return NewValidationError(fieldName, value, "min",
 				fmt.Sprintf("array length must be at least %.0f", v.Min))
 		}
+	case reflect.Map:
+		if float64(val.Len()) < v.Min {
+			return NewValidationError(fieldName, value, "min",
+				fmt.Sprintf("map size must be at least %.0f", v.Min))
+		}
 	default:
 		return NewValidationError(fieldName, value, "min",
 			fmt.Sprintf("min validation not supported for type %T", value))
@@ -122,7 +140,8 @@ func (v *MinValidator) Validate(fieldName string, value interface{}) error {
 	return nil
 }
 
-// MaxValidator checks that a numeric value or string length is at most the maximum
+// MaxValidator checks that a numeric value or string length is at most the maximum.
+// String length is counted in characters (runes), not bytes.
 type MaxValidator struct {
 	Max float64
 }
@@ -148,9 +167,18 @@ func (v *MaxValidator) Validate(fieldName string, value interface{}) error {
 		val = val.Elem()
 	}
 
+	// big.Int and big.Float are compared exactly rather than by kind
+	if cmp, ok := compareBigNumber(value, v.Max); ok {
+		if cmp > 0 {
+			return NewValidationError(fieldName, value, "max",
+				fmt.Sprintf("value must be at most %g", v.Max))
+		}
+		return nil
+	}
+
 	switch val.Kind() {
 	case reflect.String:
-		if float64(len(val.String())) > v.Max {
+		if float64(utf8.RuneCountInString(val.String())) > v.Max {
 			return NewValidationError(fieldName, value, "max",
 				fmt.Sprintf("string length must be at most %.0f characters", v.Max))
 		}
@@ -174,6 +202,11 @@ func (v *MaxValidator) Validate(fieldName string, value interface{}) error {
 			return NewValidationError(fieldName, value, "max",
 				fmt.Sprintf("array length must be at most %.0f", v.Max))
 		}
+	case reflect.Map:
+		if float64(val.Len()) > v.Max {
+			return NewValidationError(fieldName, value, "max",
+				fmt.Sprintf("map size must be at most %.0f", v.Max))
+		}
 	default:
 		return NewValidationError(fieldName, value, "max",
 			fmt.Sprintf("max validation not supported for type %T", value))
@@ -272,13 +305,18 @@ func (v *EmailValidator) Validate(fieldName string, value interface{}) error {
 	return nil
 }
 
-// LengthValidator checks exact length for strings and arrays
+// LengthValidator checks exact length for strings (in characters, not bytes), and
+// element count for slices, arrays, and maps
 type LengthValidator struct {
 	Length int
+	rule   string // tag name the validator was registered under ("length" or "len")
 }
 
 // Name returns the validator name
 func (v *LengthValidator) Name() string {
+	if v.rule != "" {
+		return v.rule
+	}
 	return "length"
 }
 
@@ -302,16 +340,16 @@ func (v *LengthValidator) Validate(fieldName string, value interface{}) error {
 
 	switch val.Kind() {
 	case reflect.String:
-		actualLength = len(val.String())
-	case reflect.Slice, reflect.Array:
+		actualLength = utf8.RuneCountInString(val.String())
+	case reflect.Slice, reflect.Array, reflect.Map:
 		actualLength = val.Len()
 	default:
-		return NewValidationError(fieldName, value, "length",
+		return NewValidationError(fieldName, value, v.Name(),
 			fmt.Sprintf("length validation not supported for type %T", value))
 	}
 
 	if actualLength != v.Length {
-		return NewValidationError(fieldName, value, "length",
+		return NewValidationError(fieldName, value, v.Name(),
 			fmt.Sprintf("length must be exactly %d", v.Length))
 	}
 
@@ -401,3 +439,454 @@ func (v *AlphanumValidator) Validate(fieldName string, value interface{}) error
 
 	return nil
 }
+
+// AlphaUnicodeValidator checks that a string contains only Unicode letters.
+// Combining marks are accepted so that decomposed accents and scripts such as
+// Devanagari validate as letters.
+type AlphaUnicodeValidator struct{}
+
+// Name returns the validator name
+func (v *AlphaUnicodeValidator) Name() string {
+	return "alphaunicode"
+}
+
+// Validate checks if the value contains only Unicode letters
+func (v *AlphaUnicodeValidator) Validate(fieldName string, value interface{}) error {
+	return validateRunes(fieldName, value, "alphaunicode", "value must contain only letters", func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsMark(r)
+	})
+}
+
+// AlphanumUnicodeValidator checks that a string contains only Unicode letters and digits
+type AlphanumUnicodeValidator struct{}
+
+// Name returns the validator name
+func (v *AlphanumUnicodeValidator) Name() string {
+	return "alphanumunicode"
+}
+
+// Validate checks if the value contains only Unicode letters and digits
+func (v *AlphanumUnicodeValidator) Validate(fieldName string, value interface{}) error {
+	return validateRunes(fieldName, value, "alphanumunicode", "value must contain only letters and digits", func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)
+	})
+}
+
+// NumericValidator checks that a string holds a decimal number: digits with an optional
+// leading sign and fractional part (e.g. "-12.50"). The value stays a string.
+type NumericValidator struct{}
+
+// Name returns the validator name
+func (v *NumericValidator) Name() string {
+	return "numeric"
+}
+
+var numericRegex = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
+
+// Validate checks if the value is a numeric string
+func (v *NumericValidator) Validate(fieldName string, value interface{}) error {
+	return validateStringPattern(fieldName, value, "numeric", "value must be a numeric string", numericRegex)
+}
+
+// NumberValidator checks that a string contains only the digits 0-9, preserving
+// leading zeros (e.g. "00042").
+type NumberValidator struct{}
+
+// Name returns the validator name
+func (v *NumberValidator) Name() string {
+	return "number"
+}
+
+var numberRegex = regexp.MustCompile(`^[0-9]+$`)
+
+// Validate checks if the value contains only digits
+func (v *NumberValidator) Validate(fieldName string, value interface{}) error {
+	return validateStringPattern(fieldName, value, "number", "value must contain only digits", numberRegex)
+}
+
+// ContainsValidator checks that a string contains a substring: `validate:"contains=@"`
+type ContainsValidator struct {
+	Substring string
+}
+
+// Name returns the validator name
+func (v *ContainsValidator) Name() string {
+	return "contains"
+}
+
+// Validate checks if the value contains the substring
+func (v *ContainsValidator) Validate(fieldName string, value interface{}) error {
+	return validateSubstring(fieldName, value, "contains", v.Substring, strings.Contains, "value must contain %q")
+}
+
+// StartsWithValidator checks that a string starts with a prefix: `validate:"startswith=https://"`
+type StartsWithValidator struct {
+	Prefix string
+}
+
+// Name returns the validator name
+func (v *StartsWithValidator) Name() string {
+	return "startswith"
+}
+
+// Validate checks if the value starts with the prefix
+func (v *StartsWithValidator) Validate(fieldName string, value interface{}) error {
+	return validateSubstring(fieldName, value, "startswith", v.Prefix, strings.HasPrefix, "value must start with %q")
+}
+
+// EndsWithValidator checks that a string ends with a suffix: `validate:"endswith=.com"`
+type EndsWithValidator struct {
+	Suffix string
+}
+
+// Name returns the validator name
+func (v *EndsWithValidator) Name() string {
+	return "endswith"
+}
+
+// Validate checks if the value ends with the suffix
+func (v *EndsWithValidator) Validate(fieldName string, value interface{}) error {
+	return validateSubstring(fieldName, value, "endswith", v.Suffix, strings.HasSuffix, "value must end with %q")
+}
+
+// validateSubstring applies a strings.Contains-style check. Nil pointers and empty strings
+// pass; they are handled by the required validator. The substring is included in the
+// message and in Details["substring"].
+func validateSubstring(fieldName string, value interface{}, rule, substring string, match func(s, substr string) bool, format string) error {
+	str, ok, err := stringForValidation(fieldName, value, rule)
+	if !ok || str == "" {
+		return err
+	}
+
+	if !match(str, substring) {
+		return NewValidationErrorWithDetails(fieldName, fieldName, value, rule,
+			fmt.Sprintf(format, substring), map[string]interface{}{"substring": substring})
+	}
+	return nil
+}
+
+// IPValidator checks that a string is an IP address literal. Version restricts the
+// accepted family: 4 for IPv4, 6 for IPv6, and 0 for either.
+type IPValidator struct {
+	Version int
+}
+
+// Name returns the validator name
+func (v *IPValidator) Name() string {
+	switch v.Version {
+	case 4:
+		return "ipv4"
+	case 6:
+		return "ipv6"
+	default:
+		return "ip"
+	}
+}
+
+// Validate checks if the value is an IP address of the configured version
+func (v *IPValidator) Validate(fieldName string, value interface{}) error {
+	rule := v.Name()
+	str, ok, err := stringForValidation(fieldName, value, rule)
+	if !ok || str == "" {
+		return err
+	}
+
+	ip := net.ParseIP(str)
+	isV6Literal := strings.Contains(str, ":")
+
+	switch {
+	case ip == nil:
+		return NewValidationError(fieldName, value, rule, "value must be a valid IP address")
+	case v.Version == 4 && isV6Literal:
+		return NewValidationError(fieldName, value, rule, "value must be a valid IPv4 address")
+	case v.Version == 6 && !isV6Literal:
+		return NewValidationError(fieldName, value, rule, "value must be a valid IPv6 address")
+	}
+	return nil
+}
+
+// CIDRValidator checks that a string is CIDR notation, e.g. "10.0.0.0/8" or "2001:db8::/32"
+type CIDRValidator struct{}
+
+// Name returns the validator name
+func (v *CIDRValidator) Name() string {
+	return "cidr"
+}
+
+// Validate checks if the value is valid CIDR notation
+func (v *CIDRValidator) Validate(fieldName string, value interface{}) error {
+	str, ok, err := stringForValidation(fieldName, value, "cidr")
+	if !ok || str == "" {
+		return err
+	}
+
+	if _, _, err := net.ParseCIDR(str); err != nil {
+		return NewValidationError(fieldName, value, "cidr", "value must be valid CIDR notation (e.g. 10.0.0.0/8)")
+	}
+	return nil
+}
+
+// PortValidator checks that a value is a TCP/UDP port number between 1 and 65535.
+// Integer fields are checked by value; string fields must hold a decimal port number.
+type PortValidator struct{}
+
+// Name returns the validator name
+func (v *PortValidator) Name() string {
+	return "port"
+}
+
+// Validate checks if the value is a valid port number
+func (v *PortValidator) Validate(fieldName string, value interface{}) error {
+	if value == nil {
+		return nil // nil values are handled by required validator
+	}
+
+	val := reflect.ValueOf(value)
+
+	// Handle pointer types by dereferencing them
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil // nil pointers are not validated
+		}
+		val = val.Elem()
+	}
+
+	var port int64
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		port = val.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val.Uint() > 65535 {
+			port = 65536
+		} else {
+			port = int64(val.Uint())
+		}
+	case reflect.String:
+		if val.String() == "" {
+			return nil // empty strings are handled by required validator
+		}
+		parsed, err := strconv.ParseInt(val.String(), 10, 64)
+		if err != nil {
+			return NewValidationError(fieldName, value, "port", "value must be a port number")
+		}
+		port = parsed
+	default:
+		return NewValidationError(fieldName, value, "port",
+			fmt.Sprintf("port validation not supported for type %T", value))
+	}
+
+	if port < 1 || port > 65535 {
+		return NewValidationError(fieldName, value, "port", "port must be between 1 and 65535")
+	}
+	return nil
+}
+
+// validateStringPattern checks that a string (or string pointer) matches re.
+// Nil pointers and empty strings pass; they are handled by the required validator.
+func validateStringPattern(fieldName string, value interface{}, rule, message string, re *regexp.Regexp) error {
+	str, ok, err := stringForValidation(fieldName, value, rule)
+	if !ok || str == "" {
+		return err
+	}
+
+	if !re.MatchString(str) {
+		return NewValidationError(fieldName, value, rule, message)
+	}
+	return nil
+}
+
+// validateRunes checks that every rune of a string (or string pointer) satisfies accept.
+// Nil pointers and empty strings pass; they are handled by the required validator.
+func validateRunes(fieldName string, value interface{}, rule, message string, accept func(rune) bool) error {
+	str, ok, err := stringForValidation(fieldName, value, rule)
+	if !ok {
+		return err
+	}
+
+	for _, r := range str {
+		if !accept(r) {
+			return NewValidationError(fieldName, value, rule, message)
+		}
+	}
+	return nil
+}
+
+// stringForValidation extracts the string from a string or string pointer value.
+// It reports false for nil values, which are not validated, and returns an error
+// when the value is not a string.
+func stringForValidation(fieldName string, value interface{}, rule string) (string, bool, error) {
+	if value == nil {
+		return "", false, nil // nil values are handled by required validator
+	}
+
+	// Handle pointer types by dereferencing them
+	actualValue := value
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return "", false, nil // nil pointers are not validated
+		}
+		actualValue = val.Elem().Interface()
+	}
+
+	str, ok := actualValue.(string)
+	if !ok {
+		return "", false, NewValidationError(fieldName, value, rule, "value must be a string")
+	}
+	return str, true, nil
+}
+
+// RegexValidator checks that a string matches a regular expression supplied in the tag.
+// Patterns containing commas must be single-quoted: `validate:"regex='^[a-z]{2,4}$'"`.
+type RegexValidator struct {
+	Pattern string
+}
+
+// regexCache holds compiled patterns keyed by pattern string so each is compiled once
+var regexCache sync.Map // map[string]*regexp.Regexp
+
+// compileCachedRegex returns the compiled pattern, compiling and caching it on first use
+func compileCachedRegex(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := regexCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := regexCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
+
+// Name returns the validator name
+func (v *RegexValidator) Name() string {
+	return "regex"
+}
+
+// Validate checks if the value matches the configured pattern
+func (v *RegexValidator) Validate(fieldName string, value interface{}) error {
+	if value == nil {
+		return nil // nil values are handled by required validator
+	}
+
+	// Handle pointer types by dereferencing them
+	actualValue := value
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil // nil pointers are not validated
+		}
+		actualValue = val.Elem().Interface()
+	}
+
+	str, ok := actualValue.(string)
+	if !ok {
+		return NewValidationError(fieldName, value, "regex", "value must be a string")
+	}
+
+	if str == "" {
+		return nil // empty strings are handled by required validator
+	}
+
+	re, err := compileCachedRegex(v.Pattern)
+	if err != nil {
+		return NewValidationError(fieldName, value, "regex",
+			fmt.Sprintf("invalid regex pattern %q: %v", v.Pattern, err))
+	}
+
+	if !re.MatchString(str) {
+		return NewValidationErrorWithDetails(fieldName, fieldName, value, "regex",
+			fmt.Sprintf("value must match pattern %s", v.Pattern),
+			map[string]interface{}{"pattern": v.Pattern})
+	}
+
+	return nil
+}
+
+// Now returns the current time used by time-relative validators such as future and past.
+// Tests can replace it to freeze time and restore it afterwards:
+//
+//	model.Now = func() time.Time { return fixed }
+//	defer func() { model.Now = time.Now }()
+//
+// It is read without synchronization, so replace it only while no validation is running.
+var Now = time.Now
+
+// FutureValidator checks that a time.Time value lies after the current time.
+// Tolerance allows values up to that long in the past, to absorb clock skew
+// between the client that produced the value and this process.
+type FutureValidator struct {
+	Tolerance time.Duration
+}
+
+// Name returns the validator name
+func (v *FutureValidator) Name() string {
+	return "future"
+}
+
+// Validate checks if the time is in the future
+func (v *FutureValidator) Validate(fieldName string, value interface{}) error {
+	t, ok, err := timeForValidation(fieldName, value, "future")
+	if !ok {
+		return err
+	}
+
+	if !t.After(Now().Add(-v.Tolerance)) {
+		return NewValidationErrorWithDetails(fieldName, fieldName, value, "future",
+			"time must be in the future",
+			map[string]interface{}{"tolerance": v.Tolerance.String()})
+	}
+
+	return nil
+}
+
+// PastValidator checks that a time.Time value lies before the current time.
+// Tolerance allows values up to that long in the future, to absorb clock skew
+// between the client that produced the value and this process.
+type PastValidator struct {
+	Tolerance time.Duration
+}
+
+// Name returns the validator name
+func (v *PastValidator) Name() string {
+	return "past"
+}
+
+// Validate checks if the time is in the past
+func (v *PastValidator) Validate(fieldName string, value interface{}) error {
+	t, ok, err := timeForValidation(fieldName, value, "past")
+	if !ok {
+		return err
+	}
+
+	if !t.Before(Now().Add(v.Tolerance)) {
+		return NewValidationErrorWithDetails(fieldName, fieldName, value, "past",
+			"time must be in the past",
+			map[string]interface{}{"tolerance": v.Tolerance.String()})
+	}
+
+	return nil
+}
+
+// timeForValidation extracts a time.Time from value for the named rule. It reports
+// false when there is nothing to check (nil, nil pointer, or zero time, which are
+// left to the required validator) or when value is not a time, in which case the
+// returned error describes the mismatch.
+func timeForValidation(fieldName string, value interface{}, rule string) (time.Time, bool, error) {
+	switch t := value.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return t, !t.IsZero(), nil
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, false, nil
+		}
+		return *t, !t.IsZero(), nil
+	default:
+		return time.Time{}, false, NewValidationError(fieldName, value, rule,
+			fmt.Sprintf("%s validation not supported for type %T", rule, value))
+	}
+}
@@ -0,0 +1,248 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// variantSet is the registration for one base interface
+type variantSet struct {
+	discriminator string
+	types         map[string]variantType
+}
+
+// variantType is one concrete variant and how it satisfies the base interface
+type variantType struct {
+	typ     reflect.Type // struct type parsed into
+	pointer bool         // whether *typ, rather than typ, implements the base interface
+}
+
+var (
+	variantsMu sync.RWMutex
+	variants   = map[reflect.Type]*variantSet{}
+)
+
+// RegisterVariant registers the concrete types of a discriminated union for ParseVariant.
+// Base must be an interface type. discriminator is the input key that names the variant,
+// and types maps each of its values to a struct type (or pointer to struct type) whose
+// value or pointer implements Base. Registering again for the same Base replaces the
+// previous registration. It is safe to call concurrently with parsing.
+//
+// Example:
+//
+//	type Shape interface{ Area() float64 }
+//
+//	err := model.RegisterVariant[Shape]("kind", map[string]reflect.Type{
+//	    "circle": reflect.TypeOf(Circle{}),
+//	    "square": reflect.TypeOf(Square{}),
+//	})
+func RegisterVariant[Base any](discriminator string, types map[string]reflect.Type) error {
+	baseType := reflect.TypeOf((*Base)(nil)).Elem()
+	if baseType.Kind() != reflect.Interface {
+		return fmt.Errorf("RegisterVariant: base type %s is not an interface", baseType)
+	}
+	if discriminator == "" {
+		return fmt.Errorf("RegisterVariant: discriminator field is empty")
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("RegisterVariant: no variants given for %s", baseType)
+	}
+
+	set := &variantSet{discriminator: discriminator, types: make(map[string]variantType, len(types))}
+	for name, typ := range types {
+		if typ == nil {
+			return fmt.Errorf("RegisterVariant: variant %q of %s has no type", name, baseType)
+		}
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return fmt.Errorf("RegisterVariant: variant %q of %s is %s, not a struct", name, baseType, typ)
+		}
+
+		switch {
+		case typ.Implements(baseType):
+			set.types[name] = variantType{typ: typ}
+		case reflect.PointerTo(typ).Implements(baseType):
+			set.types[name] = variantType{typ: typ, pointer: true}
+		default:
+			return fmt.Errorf("RegisterVariant: variant %q type %s does not implement %s", name, typ, baseType)
+		}
+	}
+
+	variantsMu.Lock()
+	defer variantsMu.Unlock()
+	variants[baseType] = set
+
+	// Types with fields of type Base now need map-based coercion
+	mapCoercionTypes.Range(func(key, value interface{}) bool {
+		mapCoercionTypes.Delete(key)
+		return true
+	})
+	return nil
+}
+
+// lookupVariants returns the registration for baseType, or nil
+func lookupVariants(baseType reflect.Type) *variantSet {
+	variantsMu.RLock()
+	defer variantsMu.RUnlock()
+	return variants[baseType]
+}
+
+// names returns the registered discriminator values, sorted
+func (s *variantSet) names() []string {
+	names := make([]string, 0, len(s.types))
+	for name := range s.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseVariant parses raw into the variant of Base named by its discriminator field, as
+// registered with RegisterVariant. The selected struct is coerced and validated exactly as
+// ParseInto would, and returned as Base (a pointer when only the pointer type implements
+// Base). A missing or unknown discriminator fails with a ParseError on that field listing
+// the registered values.
+//
+// Example:
+//
+//	shape, err := model.ParseVariant[Shape]([]byte(`{"kind": "circle", "radius": "2"}`))
+//	circle, ok := shape.(Circle)
+func ParseVariant[Base any](raw []byte) (Base, error) {
+	var zero Base
+	baseType := reflect.TypeOf((*Base)(nil)).Elem()
+
+	set := lookupVariants(baseType)
+	if set == nil {
+		return zero, fmt.Errorf("ParseVariant: no variants registered for %s", baseType)
+	}
+
+	if err := checkInputSize(len(raw)); err != nil {
+		return zero, err
+	}
+
+	// Parse checks structure depth and array lengths as it decodes
+	format := DetectFormat(raw)
+	data, err := GetParser(format).Parse(raw)
+	if err != nil {
+		return zero, err
+	}
+
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return zero, fmt.Errorf("cannot parse non-object data into %s", baseType)
+	}
+
+	variant, err := set.resolve(dataMap, "", "")
+	if err != nil {
+		return zero, err
+	}
+
+	resultPtr := reflect.New(variant.typ)
+	if errs := parseStructMap(context.Background(), dataMap, resultPtr.Elem(), format); errs.hasFatal() {
+		return zero, errs.AsError()
+	}
+
+	if variant.pointer {
+		return resultPtr.Interface().(Base), nil
+	}
+	return resultPtr.Elem().Interface().(Base), nil
+}
+
+// resolve selects the variant named by the discriminator field of dataMap. key overrides
+// the registered discriminator when not empty; fieldName is the path of the value being
+// parsed, prefixed to the discriminator in errors.
+func (s *variantSet) resolve(dataMap map[string]interface{}, key, fieldName string) (variantType, error) {
+	if key == "" {
+		key = s.discriminator
+	}
+	path := key
+	if fieldName != "" {
+		path = fieldName + "." + key
+	}
+	expected := strings.Join(s.names(), ", ")
+
+	value, present := dataMap[key]
+	if !present || value == nil {
+		return variantType{}, NewParseError(path, nil, "string",
+			fmt.Sprintf("missing discriminator field; expected one of: %s", expected))
+	}
+
+	name, err := coerceToString(value, path)
+	if err != nil {
+		return variantType{}, err
+	}
+
+	variant, ok := s.types[name]
+	if !ok {
+		return variantType{}, NewParseError(path, value, "string",
+			fmt.Sprintf("unknown variant %q; expected one of: %s", name, expected))
+	}
+	return variant, nil
+}
+
+// discriminatorKey is the context key under which a field's discriminator tag is threaded
+// to the coercion of its value
+type discriminatorKey struct{}
+
+// withDiscriminator returns ctx carrying the discriminator tag key, or clearing it when empty
+func withDiscriminator(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, discriminatorKey{}, key)
+}
+
+// discriminatorFrom returns the discriminator tag carried by ctx, or ""
+func discriminatorFrom(ctx context.Context) string {
+	key, _ := ctx.Value(discriminatorKey{}).(string)
+	return key
+}
+
+// isVariantTarget reports whether values for the interface type targetType are parsed into
+// a registered variant: always when variants are registered for a non-empty interface,
+// and for interface{} only under a discriminator tag
+func isVariantTarget(ctx context.Context, targetType reflect.Type) bool {
+	if targetType.Kind() != reflect.Interface {
+		return false
+	}
+	if discriminatorFrom(ctx) != "" {
+		return true
+	}
+	return targetType.NumMethod() > 0 && lookupVariants(targetType) != nil
+}
+
+// coerceToVariant parses an object into the registered variant of the interface type
+// targetType that its discriminator names. Nested fields are coerced and validated as
+// for any struct.
+func coerceToVariant(ctx context.Context, value interface{}, targetType reflect.Type, fieldName string, format Format) (interface{}, error) {
+	set := lookupVariants(targetType)
+	if set == nil {
+		return nil, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("no variants registered for %s", targetType))
+	}
+
+	sourceMap, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, NewParseError(fieldName, value, targetType.String(),
+			fmt.Sprintf("cannot coerce %T to %s", value, targetType))
+	}
+
+	variant, err := set.resolve(sourceMap, discriminatorFrom(ctx), fieldName)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := coerceToStructWithFormat(withDiscriminator(ctx, ""), sourceMap, variant.typ, fieldName, format)
+	if err != nil {
+		return nil, err
+	}
+	if variant.pointer {
+		ptr := reflect.New(variant.typ)
+		ptr.Elem().Set(reflect.ValueOf(result))
+		return ptr.Interface(), nil
+	}
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xmlTextKey holds the text of an XML element that also has attributes or child elements.
+// Fields tagged xml:",chardata" read it.
+const xmlTextKey = "#text"
+
+// XMLParser implements FormatParser for XML format.
+// The root element becomes the parsed object: its attributes and child elements are keys
+// named by their local names, repeated child elements become arrays, and elements with
+// only text become strings for coercion. Namespace declarations are dropped.
+type XMLParser struct{}
+
+// Parse parses XML data into a generic interface{}
+func (xp *XMLParser) Parse(raw []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(trimUTF8BOM(raw)))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("xml parse error: no root element")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("xml parse error: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue // prolog, comments, and whitespace before the root
+		}
+		data, err := decodeXMLElement(decoder, start)
+		if err != nil {
+			return nil, fmt.Errorf("xml parse error: %w", err)
+		}
+		// Check structure depth and array lengths to prevent resource exhaustion
+		if err := checkStructureLimits(data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+// Format returns the XML format type
+func (xp *XMLParser) Format() Format {
+	return FormatXML
+}
+
+// decodeXMLElement decodes the element opened by start. Elements with neither attributes
+// nor child elements decode to their trimmed text, or nil when empty; others decode to an
+// object, keeping any text under xmlTextKey. A child element replaces an attribute of the
+// same name.
+func decodeXMLElement(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	element := make(map[string]interface{}, len(start.Attr))
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		element[attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	children := map[string]bool{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			addXMLChild(element, children, t.Name.Local, child)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				if content == "" {
+					return nil, nil
+				}
+				return content, nil
+			}
+			if content != "" {
+				element[xmlTextKey] = content
+			}
+			return element, nil
+		}
+	}
+}
+
+// addXMLChild stores a child element under name, collecting repeated elements into an array
+func addXMLChild(element map[string]interface{}, children map[string]bool, name string, child interface{}) {
+	if !children[name] {
+		children[name] = true
+		element[name] = child
+		return
+	}
+	if items, ok := element[name].([]interface{}); ok {
+		element[name] = append(items, child)
+		return
+	}
+	element[name] = []interface{}{element[name], child}
+}
+
+// hasTagOption reports whether the comma-separated options of a struct tag include option
+func hasTagOption(tag, option string) bool {
+	_, options, _ := strings.Cut(tag, ",")
+	for options != "" {
+		var current string
+		current, options, _ = strings.Cut(options, ",")
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type AliasContact struct {
+	Email string `json:"email" yaml:"email" aliases:"e_mail,mail" validate:"required,email"`
+	Age   int    `json:"age" yaml:"age" aliases:"years"`
+}
+
+// TestAliases_Lookup verifies the primary key is tried first, then aliases in order
+func TestAliases_Lookup(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"primary key", `{"email":"a@x.io"}`, "a@x.io"},
+		{"first alias", `{"e_mail":"b@x.io"}`, "b@x.io"},
+		{"second alias", `{"mail":"c@x.io"}`, "c@x.io"},
+		{"primary wins over aliases", `{"mail":"c@x.io","email":"a@x.io","e_mail":"b@x.io"}`, "a@x.io"},
+		{"earlier alias wins", `{"mail":"c@x.io","e_mail":"b@x.io"}`, "b@x.io"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contact, err := model.ParseInto[AliasContact]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if contact.Email != tt.want {
+				t.Errorf("Email = %q, want %q", contact.Email, tt.want)
+			}
+		})
+	}
+}
+
+// TestAliases_CoercionAndValidation verifies aliased values are coerced and validated
+func TestAliases_CoercionAndValidation(t *testing.T) {
+	contact, err := model.ParseInto[AliasContact]([]byte(`{"mail":"a@x.io","years":"42"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if contact.Age != 42 {
+		t.Errorf("Age = %d, want 42", contact.Age)
+	}
+
+	_, err = model.ParseInto[AliasContact]([]byte(`{"e_mail":"not-an-email"}`))
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "Email" {
+		t.Errorf("expected email validation error on Email, got %v", err)
+	}
+}
+
+// TestAliases_YAML verifies aliases apply to YAML input
+func TestAliases_YAML(t *testing.T) {
+	contact, err := model.ParseIntoWithFormat[AliasContact]([]byte("e_mail: a@x.io\nyears: 30\n"), model.FormatYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if contact.Email != "a@x.io" || contact.Age != 30 {
+		t.Errorf("unexpected result: %+v", contact)
+	}
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type BatchUser struct {
+	ID    int    `json:"id" validate:"required,min=1"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+func batchInputs() [][]byte {
+	return [][]byte{
+		[]byte(`{"id":1,"email":"a@example.com"}`),
+		[]byte(`{"id":"2","email":"b@example.com"}`),
+		[]byte(`{"id":0,"email":"c@example.com"}`),
+		[]byte(`{"id":4,"email":`),
+		[]byte("id: 5\nemail: e@example.com\n"),
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	results, errs := model.ParseAll[BatchUser](batchInputs())
+	checkBatchResults(t, results, errs)
+}
+
+func TestParseAllConcurrent(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 100} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			results, errs := model.ParseAllConcurrent[BatchUser](batchInputs(), workers)
+			checkBatchResults(t, results, errs)
+		})
+	}
+}
+
+func TestParseAll_Empty(t *testing.T) {
+	results, errs := model.ParseAllConcurrent[BatchUser](nil, 4)
+	if len(results) != 0 || len(errs) != 0 {
+		t.Errorf("ParseAllConcurrent(nil) = %v, %v, want empty", results, errs)
+	}
+}
+
+func checkBatchResults(t *testing.T, results []BatchUser, errs []error) {
+	t.Helper()
+
+	if len(results) != 5 || len(errs) != 5 {
+		t.Fatalf("got %d results and %d errors, want 5 each", len(results), len(errs))
+	}
+
+	wantErr := []bool{false, false, true, true, false}
+	for i, want := range wantErr {
+		if (errs[i] != nil) != want {
+			t.Errorf("errs[%d] = %v, wantErr %v", i, errs[i], want)
+		}
+		if want && results[i] != (BatchUser{}) {
+			t.Errorf("results[%d] = %+v, want zero value on error", i, results[i])
+		}
+	}
+
+	for _, i := range []int{0, 1, 4} {
+		if results[i].ID != i+1 {
+			t.Errorf("results[%d].ID = %d, want %d", i, results[i].ID, i+1)
+		}
+	}
+}
+
+func TestParseNDJSON(t *testing.T) {
+	input := "{\"id\":1,\"email\":\"a@example.com\"}\n" +
+		"\n" +
+		"  {\"id\":\"2\",\"email\":\"b@example.com\"}\r\n" +
+		"{\"id\":0,\"email\":\"c@example.com\"}\n" +
+		"{\"id\":4,\"email\":\n" +
+		"{\"id\":5,\"email\":\"e@example.com\"}"
+
+	var users []BatchUser
+	var errs []error
+	err := model.ParseNDJSON(strings.NewReader(input), func(user BatchUser, err error) {
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		users = append(users, user)
+	})
+	if err != nil {
+		t.Fatalf("ParseNDJSON() unexpected error = %v", err)
+	}
+
+	if len(users) != 3 || users[0].ID != 1 || users[1].ID != 2 || users[2].ID != 5 {
+		t.Errorf("parsed users = %+v, want IDs 1, 2, 5", users)
+	}
+	if len(errs) != 2 || !strings.HasPrefix(errs[0].Error(), "line 4:") || !strings.HasPrefix(errs[1].Error(), "line 5:") {
+		t.Errorf("errors = %v, want failures on lines 4 and 5", errs)
+	}
+}
+
+func TestParseNDJSON_LineTooLong(t *testing.T) {
+	original := model.GetMaxInputSize()
+	defer model.SetMaxInputSize(original)
+	model.SetMaxInputSize(64)
+
+	input := `{"id":1,"email":"a@example.com"}` + "\n" + `{"id":2,"email":"` + strings.Repeat("b", 100) + `@example.com"}` + "\n"
+
+	calls := 0
+	err := model.ParseNDJSON(strings.NewReader(input), func(BatchUser, error) { calls++ })
+	if err == nil || !strings.Contains(err.Error(), "ndjson read error") {
+		t.Errorf("ParseNDJSON() error = %v, want read error for oversized line", err)
+	}
+	if calls != 1 {
+		t.Errorf("callback called %d times, want 1 before the oversized line", calls)
+	}
+}
+
+// TestParseNDJSON_LineAtLimit verifies a line exactly MaxInputSize bytes long is read and
+// one byte more stops reading
+func TestParseNDJSON_LineAtLimit(t *testing.T) {
+	original := model.GetMaxInputSize()
+	defer model.SetMaxInputSize(original)
+
+	line := `{"id":1,"email":"a@example.com"}`
+	model.SetMaxInputSize(len(line))
+
+	var users []BatchUser
+	err := model.ParseNDJSON(strings.NewReader(line+"\n"+line+"\n"), func(user BatchUser, err error) {
+		if err != nil {
+			t.Errorf("callback error = %v", err)
+			return
+		}
+		users = append(users, user)
+	})
+	if err != nil || len(users) != 2 {
+		t.Fatalf("ParseNDJSON() error = %v, parsed %d users, want nil and 2", err, len(users))
+	}
+
+	longer := `{"id":1,"email":"ab@example.com"}`
+	err = model.ParseNDJSON(strings.NewReader(longer+"\n"), func(BatchUser, error) {})
+	if err == nil || !strings.Contains(err.Error(), "ndjson read error") {
+		t.Errorf("ParseNDJSON() error = %v, want read error for a line one byte over", err)
+	}
+}
@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -102,6 +104,30 @@ func BenchmarkArrayParsing(b *testing.B) {
 	}
 }
 
+// Benchmark: Coercion path over a 1000-record array (every record needs string->int/bool coercion)
+func BenchmarkArrayParsing_Coercion1000(b *testing.B) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `{"id":"%d","name":"User %d","email":"user%d@example.com","age":"%d","created_at":"2023-01-01T12:00:00Z","active":"true"}`,
+			i+1, i, i, 18+i%80)
+	}
+	buf.WriteByte(']')
+	data := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := model.ParseInto[[]BenchUser](data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // Benchmark: Type coercion performance
 func BenchmarkTypeCoercion(b *testing.B) {
 	data := []byte(`{
@@ -260,3 +286,154 @@ func BenchmarkJSONParsing(b *testing.B) {
 		}
 	}
 }
+
+// Deeply nested configuration used to measure validation metadata caching
+type ApplicationConfig struct {
+	Name     string         `json:"name" validate:"required"`
+	Version  string         `json:"version" validate:"required"`
+	Server   ServerSettings `json:"server"`
+	Database DBSettings     `json:"database"`
+	Cache    CacheSettings  `json:"cache"`
+}
+
+type ServerSettings struct {
+	Host string      `json:"host" validate:"required"`
+	Port int         `json:"port" validate:"min=1,max=65535"`
+	TLS  TLSSettings `json:"tls"`
+}
+
+type TLSSettings struct {
+	Enabled  bool   `json:"enabled"`
+	CertFile string `json:"cert_file" validate:"required_if=Enabled true"`
+	KeyFile  string `json:"key_file" validate:"required_if=Enabled true"`
+}
+
+type DBSettings struct {
+	Driver string       `json:"driver" validate:"required,alpha"`
+	DSN    string       `json:"dsn" validate:"required"`
+	Pool   PoolSettings `json:"pool"`
+}
+
+type PoolSettings struct {
+	MinConns int `json:"min_conns" validate:"min=0"`
+	MaxConns int `json:"max_conns" validate:"min=1,gtefield=MinConns"`
+}
+
+type CacheSettings struct {
+	Backend string `json:"backend" validate:"required"`
+	TTL     int    `json:"ttl" validate:"min=1"`
+}
+
+var applicationConfigJSON = []byte(`{
+	"name": "api",
+	"version": "1.2.3",
+	"server": {"host": "0.0.0.0", "port": "8443", "tls": {"enabled": true, "cert_file": "c.pem", "key_file": "k.pem"}},
+	"database": {"driver": "postgres", "dsn": "postgres://localhost/app", "pool": {"min_conns": 2, "max_conns": 10}},
+	"cache": {"backend": "memory", "ttl": 300}
+}`)
+
+// Benchmark: Repeated parsing of a nested type with cached validation metadata
+func BenchmarkNestedConfig_CachedValidationTags(b *testing.B) {
+	if _, err := model.ParseInto[ApplicationConfig](applicationConfigJSON); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := model.ParseInto[ApplicationConfig](applicationConfigJSON); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Benchmark: Same workload with the validation cache cleared before every parse
+func BenchmarkNestedConfig_UncachedValidationTags(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		model.ClearValidationCache()
+		b.StartTimer()
+
+		if _, err := model.ParseInto[ApplicationConfig](applicationConfigJSON); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Flat configuration: primitive fields only, so the coercion path validates in one pass
+type FlatConfig struct {
+	Name     string  `json:"name" validate:"required,min=2"`
+	Host     string  `json:"host" validate:"required"`
+	Port     int     `json:"port" validate:"min=1,max=65535"`
+	Workers  int     `json:"workers" validate:"min=1"`
+	Ratio    float64 `json:"ratio" validate:"max=1"`
+	Debug    bool    `json:"debug"`
+	Region   string  `json:"region"`
+	Capacity uint32  `json:"capacity"`
+}
+
+// Benchmark: Coercion path for a flat struct (every numeric and bool field is quoted)
+func BenchmarkFlatConfig_Coercion(b *testing.B) {
+	data := []byte(`{
+		"name": "api", "host": "localhost", "port": "8080", "workers": "4",
+		"ratio": "0.5", "debug": "true", "region": "eu", "capacity": "1000"
+	}`)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := model.ParseInto[FlatConfig](data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Correctly typed record that still takes the coercion path because of its default tag
+type TypedRecord struct {
+	ID      int64   `json:"id"`
+	Count   int     `json:"count"`
+	Size    uint32  `json:"size"`
+	Price   float64 `json:"price"`
+	Score   float64 `json:"score"`
+	Name    string  `json:"name"`
+	Enabled bool    `json:"enabled"`
+	Region  string  `json:"region" default:"eu"`
+}
+
+// Benchmark: Coercion path where every input value already has its field's type
+func BenchmarkCoercion_MatchingTypes(b *testing.B) {
+	data := []byte(`{"id": 9007199254740993, "count": 4096, "size": 70000, "price": 19.99,
+		"score": 0.75, "name": "widget", "enabled": true}`)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := model.ParseInto[TypedRecord](data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Benchmark: Invalid input on a wide struct, collecting every error
+func BenchmarkValidation_CollectAll(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := model.ParseInto[WideForm](wideFormInput); err == nil {
+			b.Fatal("expected errors")
+		}
+	}
+}
+
+// Benchmark: Same input stopping at the first error
+func BenchmarkValidation_FailFast(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := model.ParseIntoFailFast[WideForm](wideFormInput); err == nil {
+			b.Fatal("expected an error")
+		}
+	}
+}
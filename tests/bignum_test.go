@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type LedgerEntry struct {
+	Account string     `json:"account" yaml:"account"`
+	Amount  *big.Int   `json:"amount" yaml:"amount" validate:"required,min=1"`
+	Balance big.Int    `json:"balance" yaml:"balance"`
+	Rate    *big.Float `json:"rate" yaml:"rate" validate:"max=1"`
+}
+
+func TestParseInto_BigNumbers(t *testing.T) {
+	const huge = "123456789012345678901234567890"
+
+	// The YAML input decodes without coercion, so big.Float's own UnmarshalText
+	// (64-bit precision) applies instead of gopantic's digit-preserving parse.
+	tests := []struct {
+		name          string
+		input         string
+		fullPrecision bool
+	}{
+		{"string input", `{"account":1001,"amount":"` + huge + `","balance":"-` + huge + `","rate":"0.123456789012345678901234567890"}`, true},
+		{"yaml string input", "account: 1001\namount: \"" + huge + "\"\nbalance: \"-" + huge + "\"\nrate: \"0.123456789012345678901234567890\"\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := model.ParseInto[LedgerEntry]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() unexpected error = %v", err)
+			}
+
+			if entry.Account != "1001" {
+				t.Errorf("Account = %q, want 1001", entry.Account)
+			}
+			if entry.Amount == nil || entry.Amount.String() != huge {
+				t.Errorf("Amount = %v, want %s", entry.Amount, huge)
+			}
+			if entry.Balance.String() != "-"+huge {
+				t.Errorf("Balance = %s, want -%s", entry.Balance.String(), huge)
+			}
+			if entry.Rate == nil || !strings.HasPrefix(entry.Rate.Text('f', 30), "0.1234567890123456789") {
+				t.Errorf("Rate = %v, want 0.1234567890123456789...", entry.Rate)
+			}
+			if tt.fullPrecision && entry.Rate.Text('f', 30) != "0.123456789012345678901234567890" {
+				t.Errorf("Rate = %s, want full precision", entry.Rate.Text('f', 30))
+			}
+		})
+	}
+}
+
+func TestParseInto_BigNumbersFromNumbers(t *testing.T) {
+	entry, err := model.ParseInto[LedgerEntry]([]byte(`{"account":7,"amount":42,"balance":1e3,"rate":0.5}`))
+	if err != nil {
+		t.Fatalf("ParseInto() unexpected error = %v", err)
+	}
+	if entry.Amount.Int64() != 42 || entry.Balance.Int64() != 1000 {
+		t.Errorf("Amount, Balance = %v, %s, want 42, 1000", entry.Amount, entry.Balance.String())
+	}
+	if f, _ := entry.Rate.Float64(); f != 0.5 {
+		t.Errorf("Rate = %v, want 0.5", entry.Rate)
+	}
+}
+
+// TestParseInto_BigNumberExponentLimit verifies exponent forms cannot expand beyond the
+// digits MaxInputSize allows
+func TestParseInto_BigNumberExponentLimit(t *testing.T) {
+	orig := model.GetMaxInputSize()
+	defer model.SetMaxInputSize(orig)
+	model.SetMaxInputSize(1000)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"big.Int within limit", `{"account":1,"amount":1e900}`, false},
+		{"big.Int number over limit", `{"account":1,"amount":1e50000000}`, true},
+		{"big.Float number over limit", `{"account":1,"amount":1,"rate":1e-50000000}`, true},
+		{"big.Float string over limit", `{"account":1,"amount":1,"rate":"1e50000000"}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[LedgerEntry]([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInto() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "exceeds the maximum of 1000 digits") {
+				t.Errorf("unexpected error message: %v", err)
+			}
+		})
+	}
+
+	// With size checking disabled the default digit budget still applies
+	model.SetMaxInputSize(0)
+	if _, err := model.ParseInto[LedgerEntry]([]byte(`{"account":1,"amount":1e50000000}`)); err == nil {
+		t.Error("expected error for 1e50000000 with MaxInputSize disabled")
+	}
+}
+
+func TestParseInto_BigNumberErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"non-numeric string", `{"account":1,"amount":"lots"}`, "cannot parse string"},
+		{"fractional integer", `{"account":1,"amount":1.5}`, "cannot convert"},
+		{"below min", `{"account":1,"amount":"-99999999999999999999999"}`, "at least 1"},
+		{"above max", `{"account":1,"amount":"1","rate":"1.0000000000000000000001"}`, "at most 1"},
+		{"required", `{"account":1}`, "required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[LedgerEntry]([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseInto() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type BindSignup struct {
+	Email string `json:"email" yaml:"email" form:"email" validate:"required,email"`
+	Age   int    `json:"age" yaml:"age" form:"age" validate:"min=18"`
+}
+
+// newBindRequest builds a POST request with the given body and Content-Type
+func newBindRequest(body, contentType string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+// TestBindRequest_ContentTypes verifies the body format follows the Content-Type header
+func TestBindRequest_ContentTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+	}{
+		{"json", `{"email":"a@x.io","age":"30"}`, "application/json"},
+		{"json with charset", `{"email":"a@x.io","age":30}`, "application/json; charset=utf-8"},
+		{"json suffix", `{"email":"a@x.io","age":30}`, "application/vnd.api+json"},
+		{"yaml", "email: a@x.io\nage: 30\n", "application/yaml"},
+		{"form", "email=a%40x.io&age=30", "application/x-www-form-urlencoded"},
+		{"detected", "email: a@x.io\nage: 30\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signup, errs := model.BindRequest[BindSignup](newBindRequest(tt.body, tt.contentType))
+			if errs != nil {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if signup.Email != "a@x.io" || signup.Age != 30 {
+				t.Errorf("unexpected result: %+v", signup)
+			}
+		})
+	}
+}
+
+// TestBindRequest_Errors verifies failures are returned as a structured ErrorList
+func TestBindRequest_Errors(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		_, errs := model.BindRequest[BindSignup](newBindRequest(`{"email":"nope","age":12}`, "application/json"))
+		if errs == nil {
+			t.Fatal("expected errors")
+		}
+		if report := errs.ToStructuredReport(); report.Count != 2 {
+			t.Errorf("report count = %d, want 2: %v", report.Count, errs)
+		}
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		_, errs := model.BindRequest[BindSignup](newBindRequest(`{"email":`, "application/json"))
+		if errs == nil {
+			t.Fatal("expected errors")
+		}
+		report := errs.ToStructuredReportWithParseErrors()
+		if report.Count != 1 || report.Errors[0].Errors[0].Rule != "parse" {
+			t.Errorf("report = %+v, want one parse entry", report)
+		}
+
+		// ToStructuredReport keeps reporting validation errors only
+		if report := errs.ToStructuredReport(); report.Count != 0 || len(report.Errors) != 0 {
+			t.Errorf("ToStructuredReport() = %+v, want no entries", report)
+		}
+	})
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		_, errs := model.BindRequest[BindSignup](newBindRequest(`email`, "text/plain"))
+		if errs == nil || !strings.Contains(errs.Error(), "unsupported Content-Type") {
+			t.Fatalf("expected unsupported Content-Type error, got %v", errs)
+		}
+		if status := errs.HTTPStatus(); status != http.StatusUnsupportedMediaType {
+			t.Errorf("HTTPStatus() = %d, want 415", status)
+		}
+	})
+
+	t.Run("body over size limit", func(t *testing.T) {
+		orig := model.GetMaxInputSize()
+		defer model.SetMaxInputSize(orig)
+
+		body := `{"email":"a@x.io","age":30}`
+		model.SetMaxInputSize(len(body))
+		if _, errs := model.BindRequest[BindSignup](newBindRequest(body, "application/json")); errs != nil {
+			t.Fatalf("body at limit should pass: %v", errs)
+		}
+
+		_, errs := model.BindRequest[BindSignup](newBindRequest(body+" ", "application/json"))
+		if errs == nil || !strings.Contains(errs.Error(), "exceeds maximum allowed size") {
+			t.Fatalf("expected size error, got %v", errs)
+		}
+		if status := errs.HTTPStatus(); status != http.StatusRequestEntityTooLarge {
+			t.Errorf("HTTPStatus() = %d, want 413", status)
+		}
+	})
+}
+
+// TestBindHandler verifies the wrapper calls the handler or writes a 400 JSON report
+func TestBindHandler(t *testing.T) {
+	handler := model.BindHandler(func(w http.ResponseWriter, r *http.Request, req BindSignup) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(req.Email))
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, newBindRequest(`{"email":"a@x.io","age":30}`, "application/json"))
+	if rec.Code != http.StatusCreated || rec.Body.String() != "a@x.io" {
+		t.Errorf("valid request: status %d, body %q", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler(rec, newBindRequest(`{"email":"nope","age":30}`, "application/json"))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid request: status %d, want 400", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+
+	var report model.StructuredErrorReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON report: %v", err)
+	}
+	if report.Count != 1 || report.Errors[0].FieldPath != "Email" {
+		t.Errorf("report = %+v, want one Email error", report)
+	}
+}
+
+// TestBindHandler_RuleHTTPStatus verifies the response status follows SetRuleHTTPStatus
+func TestBindHandler_RuleHTTPStatus(t *testing.T) {
+	model.SetRuleHTTPStatus("min", http.StatusUnprocessableEntity)
+	defer model.SetRuleHTTPStatus("min", 0)
+
+	handler := model.BindHandler(func(w http.ResponseWriter, r *http.Request, req BindSignup) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, newBindRequest(`{"email":"a@x.io","age":16}`, "application/json"))
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status %d, want 422", rec.Code)
+	}
+
+	// Malformed input is still a bad request
+	rec = httptest.NewRecorder()
+	handler(rec, newBindRequest(`{"email":"a@x.io","age":"old"}`, "application/json"))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want 400", rec.Code)
+	}
+}
+
+// TestBindHandler_RejectedRequestStatus verifies unsupported media types answer 415 and
+// oversized bodies answer 413
+func TestBindHandler_RejectedRequestStatus(t *testing.T) {
+	orig := model.GetMaxInputSize()
+	defer model.SetMaxInputSize(orig)
+	model.SetMaxInputSize(64)
+
+	handler := model.BindHandler(func(w http.ResponseWriter, r *http.Request, req BindSignup) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	rec := httptest.NewRecorder()
+	handler(rec, newBindRequest(`email`, "text/plain"))
+	if rec.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("unsupported Content-Type: status %d, want 415", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler(rec, newBindRequest(`{"email":"`+strings.Repeat("a", 64)+`@x.io","age":30}`, "application/json"))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversized body: status %d, want 413", rec.Code)
+	}
+	var report model.StructuredErrorReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("response is not a JSON report: %v", err)
+	}
+	if report.Count != 1 || len(report.Errors[0].Errors) != 1 ||
+		!strings.HasPrefix(report.Errors[0].Errors[0].Message, "request body exceeds") {
+		t.Errorf("report = %+v, want one size error", report)
+	}
+}
+
+// TestBindRequest_FormInterfaceTarget verifies form bodies bound into an interface type
+// are rejected rather than panicking
+func TestBindRequest_FormInterfaceTarget(t *testing.T) {
+	_, errs := model.BindRequest[any](newBindRequest("email=a@x.io", "application/x-www-form-urlencoded"))
+	if errs == nil || !strings.Contains(errs.Error(), "must be a struct") {
+		t.Errorf("BindRequest() errs = %v, want must be a struct", errs)
+	}
+}
+
+// TestBindRequest_FormContext verifies form bodies pass r's context to context-aware validators
+func TestBindRequest_FormContext(t *testing.T) {
+	r := newBindRequest("username=mallory&age=30", "application/x-www-form-urlencoded")
+	r = r.WithContext(context.WithValue(r.Context(), ctxKey("blocked"), "mallory"))
+
+	_, errs := model.BindRequest[SignupRequest](r)
+	if errs == nil || !strings.Contains(errs.Error(), "username is blocked") {
+		t.Errorf("BindRequest() errs = %v, want username is blocked from the request context", errs)
+	}
+}
@@ -521,3 +521,114 @@ func TestCachedParser_DefaultConfig(t *testing.T) {
 		t.Errorf("Expected default CleanupInterval 30 minutes, got %v", config.CleanupInterval)
 	}
 }
+
+// mapBackend is an in-memory CacheBackend standing in for a shared store like Redis
+type mapBackend struct {
+	mu      sync.Mutex
+	data    map[string][]byte
+	ttls    map[string]time.Duration
+	failGet bool
+	deletes int
+}
+
+func newMapBackend() *mapBackend {
+	return &mapBackend{data: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (b *mapBackend) Get(key string) ([]byte, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failGet {
+		return nil, false, fmt.Errorf("connection refused")
+	}
+	v, ok := b.data[key]
+	return v, ok, nil
+}
+
+func (b *mapBackend) Set(key string, value []byte, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.data[key] = value
+	b.ttls[key] = ttl
+	return nil
+}
+
+func (b *mapBackend) Delete(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.data, key)
+	b.deletes++
+	return nil
+}
+
+func TestCachedParser_Backend(t *testing.T) {
+	backend := newMapBackend()
+	config := &model.CacheConfig{TTL: 5 * time.Minute, MaxEntries: 10, CleanupInterval: time.Minute, Backend: backend}
+
+	// Two parsers sharing a backend behave like two service instances
+	first := model.NewCachedParser[CacheTestUser](config)
+	defer first.Close()
+	second := model.NewCachedParser[CacheTestUser](config)
+	defer second.Close()
+
+	data := []byte(`{"id":"7","name":"Shared"}`)
+	user, err := first.Parse(data)
+	if err != nil || user.ID != 7 {
+		t.Fatalf("Parse() = %+v, %v", user, err)
+	}
+	if len(backend.data) != 1 {
+		t.Fatalf("backend has %d entries, want 1", len(backend.data))
+	}
+	for key, ttl := range backend.ttls {
+		if ttl != 5*time.Minute {
+			t.Errorf("backend TTL for %s = %v, want 5m", key, ttl)
+		}
+	}
+
+	user, err = second.Parse(data)
+	if err != nil || user != (CacheTestUser{ID: 7, Name: "Shared"}) {
+		t.Errorf("second Parse() = %+v, %v", user, err)
+	}
+	if size, _, hitRate := second.Stats(); size != 0 || hitRate != 1.0 {
+		t.Errorf("second Stats() = size %d, hit rate %v, want 0 and 1.0 (hit from backend)", size, hitRate)
+	}
+
+	// Invalid input is not cached
+	if _, err := first.Parse([]byte(`{"id":8}`)); err == nil {
+		t.Error("Parse() expected validation error")
+	}
+	if len(backend.data) != 1 {
+		t.Errorf("backend has %d entries after failed parse, want 1", len(backend.data))
+	}
+}
+
+func TestCachedParser_BackendFailuresAreMisses(t *testing.T) {
+	backend := newMapBackend()
+	parser := model.NewCachedParser[CacheTestUser](&model.CacheConfig{TTL: time.Minute, Backend: backend})
+	defer parser.Close()
+
+	data := []byte(`{"id":1,"name":"Ann"}`)
+	if _, err := parser.Parse(data); err != nil {
+		t.Fatal(err)
+	}
+
+	// Corrupt entries are deleted and re-parsed
+	for key := range backend.data {
+		backend.data[key] = []byte("not json")
+	}
+	if user, err := parser.Parse(data); err != nil || user.Name != "Ann" {
+		t.Errorf("Parse() with corrupt entry = %+v, %v", user, err)
+	}
+	if backend.deletes != 1 {
+		t.Errorf("backend deletes = %d, want 1", backend.deletes)
+	}
+
+	// An unavailable backend does not break parsing
+	backend.failGet = true
+	if user, err := parser.Parse(data); err != nil || user.Name != "Ann" {
+		t.Errorf("Parse() with failing backend = %+v, %v", user, err)
+	}
+	if _, _, hitRate := parser.Stats(); hitRate != 0 {
+		t.Errorf("Stats() hit rate = %v, want 0 with only misses", hitRate)
+	}
+}
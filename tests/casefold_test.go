@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type CaseFoldAccount struct {
+	ID      int    `json:"id" yaml:"id" validate:"required"`
+	Name    string `json:"name" yaml:"name"`
+	Profile struct {
+		Email string `json:"email" yaml:"email" validate:"required"`
+	} `json:"profile" yaml:"profile"`
+}
+
+// TestCaseInsensitiveKeys_Disabled verifies keys are matched exactly by default
+func TestCaseInsensitiveKeys_Disabled(t *testing.T) {
+	if model.GetCaseInsensitiveKeys() {
+		t.Fatal("case-insensitive keys should be disabled by default")
+	}
+
+	// The string ID forces the coercion path, which matches keys exactly
+	_, err := model.ParseInto[CaseFoldAccount]([]byte(`{"ID":"7","profile":{"email":"a@b.c"}}`))
+	if err == nil {
+		t.Error("expected required error for unmatched \"ID\" key")
+	}
+}
+
+// TestCaseInsensitiveKeys_Enabled verifies keys differing in case match when enabled
+func TestCaseInsensitiveKeys_Enabled(t *testing.T) {
+	model.SetCaseInsensitiveKeys(true)
+	defer model.SetCaseInsensitiveKeys(false)
+
+	t.Run("JSON with coercion", func(t *testing.T) {
+		account, err := model.ParseInto[CaseFoldAccount]([]byte(`{"ID":"7","Name":"Ada","Profile":{"EMAIL":"a@b.c"}}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if account.ID != 7 || account.Name != "Ada" || account.Profile.Email != "a@b.c" {
+			t.Errorf("unexpected result: %+v", account)
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		data := []byte("Id: 7\nNAME: Ada\nprofile:\n  Email: a@b.c\n")
+		account, err := model.ParseIntoWithFormat[CaseFoldAccount](data, model.FormatYAML)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if account.ID != 7 || account.Name != "Ada" || account.Profile.Email != "a@b.c" {
+			t.Errorf("unexpected result: %+v", account)
+		}
+	})
+
+	t.Run("exact match wins", func(t *testing.T) {
+		account, err := model.ParseInto[CaseFoldAccount]([]byte(`{"ID":"1","id":"2","Id":"3","profile":{"email":"a@b.c"}}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if account.ID != 2 {
+			t.Errorf("ID = %d, want 2 from the exact key", account.ID)
+		}
+	})
+
+	t.Run("ambiguous folds pick first sorted key", func(t *testing.T) {
+		account, err := model.ParseInto[CaseFoldAccount]([]byte(`{"Id":"3","ID":"1","profile":{"email":"a@b.c"}}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if account.ID != 1 {
+			t.Errorf("ID = %d, want 1 from \"ID\"", account.ID)
+		}
+	})
+}
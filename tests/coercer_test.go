@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type CoercerPriority int
+
+const (
+	CoercerPriorityLow CoercerPriority = iota + 1
+	CoercerPriorityHigh
+)
+
+type CoercerCurrency string
+
+type CoercerTicket struct {
+	Priority  CoercerPriority   `json:"priority" validate:"required"`
+	Fallback  *CoercerPriority  `json:"fallback"`
+	History   []CoercerPriority `json:"history"`
+	Currency  CoercerCurrency   `json:"currency"`
+	Reference string            `json:"reference"`
+}
+
+func init() {
+	model.RegisterCoercer(reflect.TypeOf(CoercerPriority(0)), func(value interface{}, fieldName string) (interface{}, error) {
+		switch value {
+		case "low":
+			return CoercerPriorityLow, nil
+		case "high":
+			return CoercerPriorityHigh, nil
+		}
+		return nil, fmt.Errorf("unknown priority %v", value)
+	})
+
+	// Returns a plain string, which converts to the named string type
+	model.RegisterCoercer(reflect.TypeOf(CoercerCurrency("")), func(value interface{}, fieldName string) (interface{}, error) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("currency must be a string")
+		}
+		return strings.ToUpper(s), nil
+	})
+}
+
+func TestCoercer_StringEnum(t *testing.T) {
+	got, err := model.ParseInto[CoercerTicket]([]byte(`{"priority":"high","fallback":"low","history":["low","high"],"reference":"abc"}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	if got.Priority != CoercerPriorityHigh {
+		t.Errorf("Priority = %v, want %v", got.Priority, CoercerPriorityHigh)
+	}
+	if got.Fallback == nil || *got.Fallback != CoercerPriorityLow {
+		t.Errorf("Fallback = %v, want pointer to %v", got.Fallback, CoercerPriorityLow)
+	}
+	if !reflect.DeepEqual(got.History, []CoercerPriority{CoercerPriorityLow, CoercerPriorityHigh}) {
+		t.Errorf("History = %v, want [low high]", got.History)
+	}
+	if got.Reference != "abc" {
+		t.Errorf("Reference = %q, want %q", got.Reference, "abc")
+	}
+}
+
+func TestCoercer_AppliesWhenInputAlreadyMatches(t *testing.T) {
+	got, err := model.ParseInto[CoercerTicket]([]byte(`{"priority":"low","currency":"usd"}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+	if got.Currency != "USD" {
+		t.Errorf("Currency = %q, want %q", got.Currency, "USD")
+	}
+
+	// YAML decodes straight into the named string type too
+	got, err = model.ParseIntoWithFormat[CoercerTicket]([]byte("priority: low\ncurrency: eur\n"), model.FormatYAML)
+	if err != nil {
+		t.Fatalf("ParseIntoWithFormat() error = %v", err)
+	}
+	if got.Currency != "EUR" {
+		t.Errorf("Currency = %q, want %q", got.Currency, "EUR")
+	}
+}
+
+func TestCoercer_Errors(t *testing.T) {
+	_, err := model.ParseInto[CoercerTicket]([]byte(`{"priority":"urgent"}`))
+	if err == nil || !strings.Contains(err.Error(), "unknown priority urgent") {
+		t.Errorf("ParseInto() error = %v, want coercer error", err)
+	}
+
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != "Priority" {
+		t.Errorf("ParseInto() error = %#v, want *ParseError for Priority", err)
+	}
+
+	_, err = model.CoerceValue([]interface{}{"low"}, reflect.TypeOf(CoercerPriority(0)), "priority")
+	if err == nil {
+		t.Error("CoerceValue() expected error for non-string priority")
+	}
+}
+
+func TestCoercer_WrongResultTypeAndRemoval(t *testing.T) {
+	type CoercerFlag bool
+	flagType := reflect.TypeOf(CoercerFlag(false))
+
+	model.RegisterCoercer(flagType, func(value interface{}, fieldName string) (interface{}, error) {
+		return "not a bool", nil
+	})
+	if _, err := model.CoerceValue("yes", flagType, "flag"); err == nil || !strings.Contains(err.Error(), "returned string") {
+		t.Errorf("CoerceValue() error = %v, want wrong result type error", err)
+	}
+
+	model.RegisterCoercer(flagType, nil)
+	got, err := model.CoerceValue("yes", flagType, "flag")
+	if err != nil {
+		t.Fatalf("CoerceValue() after removal error = %v", err)
+	}
+	if got != true && got != CoercerFlag(true) {
+		t.Errorf("CoerceValue() after removal = %v, want built-in bool coercion", got)
+	}
+}
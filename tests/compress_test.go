@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// gzipBytes compresses data for the compressed input tests
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseIntoCompressed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{"gzipped JSON", gzipBytes(t, []byte(`{"id": "42", "name": "Alice", "age": 30}`))},
+		{"gzipped YAML", gzipBytes(t, []byte("id: 42\nname: Alice\nage: \"30\"\n"))},
+		{"uncompressed JSON", []byte(`{"id": 42, "name": "Alice", "age": 30}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := model.ParseIntoCompressed[User](tt.input)
+			if err != nil {
+				t.Fatalf("ParseIntoCompressed() error = %v", err)
+			}
+			if user.ID != 42 || user.Name != "Alice" || user.Age != 30 {
+				t.Errorf("got %+v", user)
+			}
+		})
+	}
+}
+
+func TestParseIntoCompressed_Errors(t *testing.T) {
+	type account struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+
+	// Validation errors come from the decompressed content
+	_, err := model.ParseIntoCompressed[account](gzipBytes(t, []byte(`{"email": "nope"}`)))
+	if err == nil || !strings.Contains(err.Error(), "Email") {
+		t.Errorf("expected a validation error from gzipped input, got %v", err)
+	}
+
+	// A truncated stream is a decompression error
+	compressed := gzipBytes(t, []byte(`{"id": 1, "name": "Alice"}`))
+	_, err = model.ParseIntoCompressed[User](compressed[:len(compressed)-6])
+	if err == nil || !strings.Contains(err.Error(), "gzip decompress error") {
+		t.Errorf("expected a gzip decompress error, got %v", err)
+	}
+}
+
+func TestParseIntoCompressed_DecompressedSizeLimit(t *testing.T) {
+	original := model.GetMaxInputSize()
+	model.SetMaxInputSize(4096)
+	defer model.SetMaxInputSize(original)
+
+	// Highly compressible input well under the limit compressed, far over it expanded
+	bomb := gzipBytes(t, []byte(`{"name": "`+strings.Repeat("a", 1<<20)+`"}`))
+	if len(bomb) > 4096 {
+		t.Fatalf("compressed size %d should be under the limit", len(bomb))
+	}
+
+	_, err := model.ParseIntoCompressed[User](bomb)
+	if err == nil || !strings.Contains(err.Error(), "decompressed input exceeds maximum allowed size 4096 bytes") {
+		t.Errorf("expected a decompressed size error, got %v", err)
+	}
+}
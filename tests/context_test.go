@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type ctxKey string
+
+// takenUsernames simulates a lookup against an external store
+var takenUsernames = map[string]bool{"admin": true}
+
+func init() {
+	model.RegisterGlobalContextFunc("username_available", func(ctx context.Context, fieldName string, value interface{}, params map[string]interface{}) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if blocked, _ := ctx.Value(ctxKey("blocked")).(string); blocked == value {
+			return model.NewValidationError(fieldName, value, "username_available", "username is blocked")
+		}
+		if takenUsernames[value.(string)] {
+			return model.NewValidationError(fieldName, value, "username_available", "username is taken")
+		}
+		return nil
+	})
+}
+
+type SignupRequest struct {
+	Username string          `json:"username" validate:"required,username_available"`
+	Age      int             `json:"age" validate:"min=13"`
+	Invites  []SignupInvitee `json:"invites"`
+}
+
+type SignupInvitee struct {
+	Username string `json:"username" validate:"username_available"`
+}
+
+func TestParseIntoContext(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := model.ParseIntoContext[SignupRequest](ctx, []byte(`{"username":"alice","age":30}`)); err != nil {
+		t.Fatalf("ParseIntoContext() unexpected error = %v", err)
+	}
+
+	_, err := model.ParseIntoContext[SignupRequest](ctx, []byte(`{"username":"admin","age":30}`))
+	if err == nil || !strings.Contains(err.Error(), "username is taken") {
+		t.Errorf("ParseIntoContext() error = %v, want username is taken", err)
+	}
+}
+
+func TestParseIntoContext_PassesContextToValidators(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey("blocked"), "mallory")
+
+	inputs := map[string]string{
+		"standard decode": `{"username":"mallory","age":30}`,
+		"coercion path":   `{"username":"mallory","age":"30"}`,
+		"nested in slice": `{"username":"alice","age":"30","invites":[{"username":"bob"},{"username":"mallory"}]}`,
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			_, err := model.ParseIntoContext[SignupRequest](ctx, []byte(input))
+			if err == nil || !strings.Contains(err.Error(), "username is blocked") {
+				t.Errorf("ParseIntoContext() error = %v, want blocked error using context value", err)
+			}
+
+			// ParseInto validates with context.Background(), which blocks nobody
+			if _, err := model.ParseInto[SignupRequest]([]byte(input)); err != nil {
+				t.Errorf("ParseInto() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func TestParseIntoContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := model.ParseIntoContext[SignupRequest](ctx, []byte(`{"username":"alice","age":30}`))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParseIntoContext() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestValidateWithContext(t *testing.T) {
+	req := SignupRequest{Username: "alice", Age: 20}
+	if err := model.ValidateWithContext(context.Background(), &req); err != nil {
+		t.Fatalf("ValidateWithContext() unexpected error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	err := model.ValidateWithContext(ctx, &req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ValidateWithContext() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	// Validate uses context.Background(), so the expired deadline does not apply
+	if err := model.Validate(&req); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+}
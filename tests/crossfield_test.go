@@ -0,0 +1,419 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// TLSConfig uses required_if to make certificate paths mandatory when TLS is enabled
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled"`
+	CertFile string `json:"cert_file" validate:"required_if=Enabled true"`
+	KeyFile  string `json:"key_file" validate:"required_if=Enabled true"`
+}
+
+// ServerConfig nests TLSConfig to exercise cross-field validation on nested structs
+type ServerConfig struct {
+	Host string    `json:"host" validate:"required"`
+	TLS  TLSConfig `json:"tls"`
+}
+
+// ShippingInfo uses required_unless and required_if against a string field
+type ShippingInfo struct {
+	Method  string `json:"method"`
+	Address string `json:"address" validate:"required_unless=Method pickup"`
+	Store   string `json:"store" validate:"required_if=Method pickup"`
+}
+
+// ReferralSignup uses required_if without a value (referenced field must be non-empty)
+type ReferralSignup struct {
+	ReferralCode string `json:"referral_code"`
+	ReferredBy   string `json:"referred_by" validate:"required_if=ReferralCode"`
+}
+
+func TestRequiredIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"enabled with files", `{"enabled":true,"cert_file":"a.pem","key_file":"a.key"}`, false},
+		{"enabled without files", `{"enabled":true}`, true},
+		{"disabled without files", `{"enabled":false}`, false},
+		{"enabled coerced from string", `{"enabled":"true","cert_file":"a.pem"}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[TLSConfig]([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInto() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "required when Enabled is true") {
+				t.Errorf("unexpected error message: %v", err)
+			}
+		})
+	}
+}
+
+func TestRequiredIf_ErrorRule(t *testing.T) {
+	_, err := model.ParseInto[TLSConfig]([]byte(`{"enabled":true,"cert_file":"a.pem"}`))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ErrorList, got %T", err)
+	}
+
+	validationErrs := errs.ValidationErrors()
+	if len(validationErrs) != 1 {
+		t.Fatalf("expected 1 validation error, got %d: %v", len(validationErrs), err)
+	}
+	if validationErrs[0].Rule != "required_if" {
+		t.Errorf("Rule = %q, want required_if", validationErrs[0].Rule)
+	}
+	if validationErrs[0].Field != "KeyFile" {
+		t.Errorf("Field = %q, want KeyFile", validationErrs[0].Field)
+	}
+}
+
+func TestRequiredIf_NestedStruct(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"nested valid", `{"host":"localhost","tls":{"enabled":true,"cert_file":"a.pem","key_file":"a.key"}}`, false},
+		{"nested missing files", `{"host":"localhost","tls":{"enabled":true}}`, true},
+		{"nested missing files with coercion", `{"host":"localhost","tls":{"enabled":"yes"}}`, true},
+		{"nested disabled", `{"host":"localhost","tls":{"enabled":"no"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[ServerConfig]([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInto() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"pickup with store", `{"method":"pickup","store":"downtown"}`, ""},
+		{"pickup without store", `{"method":"pickup"}`, "required when Method is pickup"},
+		{"delivery with address", `{"method":"delivery","address":"1 Main St"}`, ""},
+		{"delivery without address", `{"method":"delivery"}`, "required unless Method is pickup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[ShippingInfo]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequiredIf_WithoutValue(t *testing.T) {
+	if _, err := model.ParseInto[ReferralSignup]([]byte(`{}`)); err != nil {
+		t.Errorf("ParseInto() unexpected error = %v", err)
+	}
+	if _, err := model.ParseInto[ReferralSignup]([]byte(`{"referral_code":"ABC"}`)); err == nil {
+		t.Error("expected error when referral_code is set without referred_by")
+	}
+}
+
+// TestRequiredIf_WithoutValueBool verifies a bare bool condition treats false as unset
+func TestRequiredIf_WithoutValueBool(t *testing.T) {
+	type Listener struct {
+		Enabled  bool   `json:"enabled"`
+		CertFile string `json:"cert_file" validate:"required_if=Enabled"`
+		Port     int    `json:"port" validate:"required_unless=Enabled"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"false needs port only", `{"enabled":false,"port":80}`, ""},
+		{"false without port", `{"enabled":false}`, "required unless Enabled is set"},
+		{"true needs cert only", `{"enabled":true,"cert_file":"a.pem"}`, ""},
+		{"true without cert", `{"enabled":true}`, "required when Enabled is set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Listener]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequiredIf_Validate(t *testing.T) {
+	cfg := TLSConfig{Enabled: true, CertFile: "a.pem", KeyFile: "a.key"}
+	if err := model.Validate(&cfg); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+
+	cfg.KeyFile = ""
+	if err := model.Validate(&cfg); err == nil {
+		t.Error("Validate() expected error for missing key file")
+	}
+}
+
+func TestRequiredIf_UnknownField(t *testing.T) {
+	type Broken struct {
+		Value string `json:"value" validate:"required_if=Missing true"`
+	}
+
+	_, err := model.ParseInto[Broken]([]byte(`{"value":"x"}`))
+	if err == nil || !strings.Contains(err.Error(), "field Missing not found") {
+		t.Errorf("expected field-not-found error, got %v", err)
+	}
+}
+
+// PriceBounds uses the built-in numeric field comparisons
+type PriceBounds struct {
+	MinPrice float64 `json:"min_price"`
+	MaxPrice float64 `json:"max_price" validate:"gtfield=MinPrice"`
+	Sale     float64 `json:"sale" validate:"gtefield=MinPrice,ltefield=MaxPrice"`
+}
+
+// QuantityBounds compares signed, unsigned, and pointer fields
+type QuantityBounds struct {
+	Low   int    `json:"low"`
+	High  uint   `json:"high" validate:"gtfield=Low"`
+	Limit *int64 `json:"limit" validate:"ltfield=Low"`
+}
+
+// Booking compares time.Time fields
+type Booking struct {
+	CheckIn  time.Time `json:"check_in"`
+	CheckOut time.Time `json:"check_out" validate:"gtfield=CheckIn"`
+}
+
+func TestFieldComparisons(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"valid range", `{"min_price":10,"max_price":20,"sale":15}`, ""},
+		{"sale at bounds", `{"min_price":10,"max_price":20,"sale":20}`, ""},
+		{"max equal min", `{"min_price":10,"max_price":10,"sale":10}`, "MaxPrice must be greater than MinPrice"},
+		{"sale below min", `{"min_price":10,"max_price":20,"sale":5}`, "Sale must be greater than or equal to MinPrice"},
+		{"sale above max", `{"min_price":10,"max_price":20,"sale":25}`, "Sale must be less than or equal to MaxPrice"},
+		{"coerced strings", `{"min_price":"10","max_price":"9.5","sale":"10"}`, "MaxPrice must be greater than MinPrice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[PriceBounds]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFieldComparisons_MixedKinds(t *testing.T) {
+	limit := int64(3)
+	valid := QuantityBounds{Low: 5, High: 10, Limit: &limit}
+	if err := model.Validate(&valid); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+
+	nilLimit := QuantityBounds{Low: 5, High: 10}
+	if err := model.Validate(&nilLimit); err != nil {
+		t.Errorf("Validate() should skip nil pointer, got %v", err)
+	}
+
+	overLimit := int64(30)
+	invalid := QuantityBounds{Low: 20, High: 10, Limit: &overLimit}
+	err := model.Validate(&invalid)
+	if err == nil {
+		t.Fatal("Validate() expected errors")
+	}
+
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ErrorList, got %T", err)
+	}
+	rules := map[string]bool{}
+	for _, ve := range errs.ValidationErrors() {
+		rules[ve.Rule] = true
+		if ve.Details["field"] != "Low" {
+			t.Errorf("Details[field] = %v, want Low", ve.Details["field"])
+		}
+	}
+	if !rules["gtfield"] || !rules["ltfield"] {
+		t.Errorf("expected gtfield and ltfield errors, got %v", err)
+	}
+}
+
+func TestFieldComparisons_Time(t *testing.T) {
+	if _, err := model.ParseInto[Booking]([]byte(`{"check_in":"2024-01-01T10:00:00Z","check_out":"2024-01-03T10:00:00Z"}`)); err != nil {
+		t.Errorf("ParseInto() unexpected error = %v", err)
+	}
+
+	_, err := model.ParseInto[Booking]([]byte(`{"check_in":"2024-01-03T10:00:00Z","check_out":"2024-01-01T10:00:00Z"}`))
+	if err == nil || !strings.Contains(err.Error(), "CheckOut must be greater than CheckIn") {
+		t.Errorf("expected time comparison error, got %v", err)
+	}
+}
+
+func TestFieldComparisons_Registered(t *testing.T) {
+	names := model.GetDefaultRegistry().ListValidators()
+	for _, want := range []string{"gtfield", "gtefield", "ltfield", "ltefield"} {
+		found := false
+		for _, name := range names {
+			if name == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("validator %q not registered in default registry", want)
+		}
+	}
+}
+
+// ClusterConfig uses excluded_with so a single-node host conflicts with a node list
+type ClusterConfig struct {
+	Host  string   `json:"host" validate:"excluded_with=Nodes"`
+	Nodes []string `json:"nodes"`
+}
+
+// AuthConfig allows at most one credential source per group
+type AuthConfig struct {
+	Token     string `json:"token" validate:"mutually_exclusive=credential"`
+	TokenFile string `json:"token_file" validate:"mutually_exclusive=credential"`
+	Password  string `json:"password" validate:"mutually_exclusive=credential"`
+	Anonymous bool   `json:"anonymous" validate:"mutually_exclusive=mode"`
+	Readonly  bool   `json:"readonly" validate:"mutually_exclusive=mode"`
+}
+
+func TestExcludedWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"host only", `{"host":"db1"}`, false},
+		{"nodes only", `{"nodes":["db1","db2"]}`, false},
+		{"empty nodes with host", `{"host":"db1","nodes":[]}`, false},
+		{"both set", `{"host":"db1","nodes":["db2"]}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[ClusterConfig]([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInto() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+
+			var validationErr *model.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected *model.ValidationError, got %T", err)
+			}
+			if validationErr.Field != "Host" || validationErr.Rule != "excluded_with" ||
+				validationErr.Details["field"] != "Nodes" {
+				t.Errorf("unexpected error: %+v", validationErr)
+			}
+			if !strings.Contains(validationErr.Message, "Host must not be set when Nodes is set") {
+				t.Errorf("unexpected message: %s", validationErr.Message)
+			}
+		})
+	}
+}
+
+func TestMutuallyExclusive(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    AuthConfig
+		conflicts []string // fields reported, in order
+	}{
+		{"none set", AuthConfig{}, nil},
+		{"one set", AuthConfig{TokenFile: "/run/token"}, nil},
+		{"two set", AuthConfig{Token: "t", Password: "p"}, []string{"Password"}},
+		{"three set", AuthConfig{Token: "t", TokenFile: "f", Password: "p"}, []string{"TokenFile", "Password"}},
+		{"false bools are unset", AuthConfig{Token: "t", Anonymous: false, Readonly: true}, nil},
+		{"groups are independent", AuthConfig{Token: "t", Anonymous: true}, nil},
+		{"bool group", AuthConfig{Anonymous: true, Readonly: true}, []string{"Readonly"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.Validate(tt.config)
+
+			var got []string
+			var errs model.ErrorList
+			var single *model.ValidationError
+			switch {
+			case errors.As(err, &errs):
+				for _, ve := range errs.ValidationErrors() {
+					got = append(got, ve.Field)
+				}
+			case errors.As(err, &single):
+				got = []string{single.Field}
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.conflicts, ",") {
+				t.Errorf("conflicts = %v, want %v (err: %v)", got, tt.conflicts, err)
+			}
+		})
+	}
+}
+
+func TestMutuallyExclusive_NamesBothFields(t *testing.T) {
+	_, err := model.ParseInto[AuthConfig]([]byte(`{"token":"t","password":"p"}`))
+
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *model.ValidationError, got %v", err)
+	}
+	if validationErr.Rule != "mutually_exclusive" || validationErr.Details["field"] != "Token" {
+		t.Errorf("unexpected error: %+v", validationErr)
+	}
+	if !strings.Contains(validationErr.Message, "Token and Password are mutually exclusive") ||
+		!strings.Contains(validationErr.Message, "Token, TokenFile, Password") {
+		t.Errorf("unexpected message: %s", validationErr.Message)
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type CSVProduct struct {
+	SKU      string    `csv:"sku" json:"id" validate:"required"`
+	Name     string    `json:"name" validate:"required,min=2"`
+	Price    float64   `csv:"price" validate:"min=0"`
+	InStock  bool      `csv:"in_stock"`
+	Added    time.Time `csv:"added"`
+	Internal string    `csv:"-" json:"internal"`
+}
+
+func TestParseCSV(t *testing.T) {
+	input := "\xEF\xBB\xBFsku,name,price,in_stock,added,internal,extra\n" +
+		"A-1,Widget,9.99,true,2024-01-15T10:00:00Z,secret,ignored\n" +
+		"B-2,\"Gadget, large\",12,0,,,\n" +
+		"C-3,Gizmo\n"
+
+	products, err := model.ParseCSV[CSVProduct]([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseCSV() unexpected error = %v", err)
+	}
+	if len(products) != 3 {
+		t.Fatalf("ParseCSV() returned %d rows, want 3", len(products))
+	}
+
+	first := products[0]
+	if first.SKU != "A-1" || first.Name != "Widget" || first.Price != 9.99 || !first.InStock {
+		t.Errorf("products[0] = %+v", first)
+	}
+	if !first.Added.Equal(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("products[0].Added = %v", first.Added)
+	}
+	if first.Internal != "" {
+		t.Errorf("products[0].Internal = %q, want column excluded by csv:\"-\"", first.Internal)
+	}
+	if products[1].Name != "Gadget, large" || products[1].Price != 12 || products[1].InStock {
+		t.Errorf("products[1] = %+v", products[1])
+	}
+	if products[2].SKU != "C-3" || products[2].Price != 0 {
+		t.Errorf("products[2] = %+v, want short row with zero price", products[2])
+	}
+}
+
+func TestParseCSV_Errors(t *testing.T) {
+	input := "sku,name,price\n" +
+		"A-1,Widget,9.99\n" +
+		"B-2,Gadget,cheap\n" +
+		",X,-1\n"
+
+	products, err := model.ParseCSV[CSVProduct]([]byte(input))
+	if err == nil {
+		t.Fatal("ParseCSV() expected error, got nil")
+	}
+	if len(products) != 3 || products[0].SKU != "A-1" || products[1].SKU != "" {
+		t.Errorf("ParseCSV() = %+v, want valid first row and zero-valued failed rows", products)
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		`row 3, column "price"`,
+		`row 4, column "sku"`,
+		`row 4, column "name"`,
+		`row 4, column "price"`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q missing %q", msg, want)
+		}
+	}
+	if strings.Contains(msg, "row 2") {
+		t.Errorf("error %q should not mention the valid row", msg)
+	}
+}
+
+func TestParseCSV_EdgeCases(t *testing.T) {
+	products, err := model.ParseCSV[CSVProduct](nil)
+	if err != nil || products != nil {
+		t.Errorf("ParseCSV(nil) = %v, %v, want nil, nil", products, err)
+	}
+
+	products, err = model.ParseCSV[CSVProduct]([]byte("sku,name\n"))
+	if err != nil || len(products) != 0 {
+		t.Errorf("ParseCSV(header only) = %v, %v, want no rows", products, err)
+	}
+
+	_, err = model.ParseCSV[CSVProduct]([]byte("sku,name\nA-1,\"unterminated\n"))
+	if err == nil || !strings.Contains(err.Error(), "csv parse error") {
+		t.Errorf("ParseCSV(bad quote) error = %v, want csv parse error", err)
+	}
+
+	_, err = model.ParseCSV[[]CSVProduct]([]byte("sku\nA\n"))
+	if err == nil {
+		t.Error("ParseCSV() into non-struct type should fail")
+	}
+}
+
+func TestParseCSV_WarningOnlyRow(t *testing.T) {
+	type csvMember struct {
+		Handle   string `csv:"handle" validate:"required"`
+		Nickname string `csv:"nickname" validate:"min=5,warn"`
+	}
+
+	input := "handle,nickname\n" +
+		"ada,ab\n" +
+		"grace,gracie\n"
+
+	members, err := model.ParseCSV[csvMember]([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseCSV() warnings alone should not fail, got %v", err)
+	}
+	if len(members) != 2 || members[0].Handle != "ada" || members[0].Nickname != "ab" {
+		t.Errorf("ParseCSV() = %+v, want the warning-only row kept", members)
+	}
+
+	// The same value is accepted by ParseInto
+	if _, err := model.ParseInto[csvMember]([]byte(`{"Handle": "ada", "Nickname": "ab"}`)); err != nil {
+		t.Errorf("ParseInto() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type DefaultsTLS struct {
+	Enabled bool   `json:"enabled" yaml:"enabled" default:"true"`
+	MinVer  string `json:"min_version" yaml:"min_version" default:"1.2"`
+}
+
+type DefaultsServer struct {
+	Host    string        `json:"host" yaml:"host" default:"localhost"`
+	Port    int           `json:"port" yaml:"port" default:"8080" validate:"port"`
+	Timeout time.Duration `json:"timeout" yaml:"timeout" default:"30s"`
+	Retries *int          `json:"retries" yaml:"retries" default:"3"`
+	Debug   bool          `json:"debug" yaml:"debug"`
+	TLS     DefaultsTLS   `json:"tls" yaml:"tls"`
+}
+
+func TestDefaults_AppliedToAbsentKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"json", `{"debug":true,"tls":{}}`},
+		{"yaml", "debug: true\ntls: {}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.ParseInto[DefaultsServer]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() error = %v", err)
+			}
+
+			if got.Host != "localhost" || got.Port != 8080 || got.Timeout != 30*time.Second {
+				t.Errorf("scalar defaults not applied: %+v", got)
+			}
+			if got.Retries == nil || *got.Retries != 3 {
+				t.Errorf("Retries = %v, want pointer to 3", got.Retries)
+			}
+			if !got.Debug {
+				t.Error("Debug = false, want value from input")
+			}
+			if !got.TLS.Enabled || got.TLS.MinVer != "1.2" {
+				t.Errorf("nested defaults not applied: %+v", got.TLS)
+			}
+		})
+	}
+}
+
+func TestDefaults_InputWins(t *testing.T) {
+	got, err := model.ParseInto[DefaultsServer]([]byte(`{"host":"example.com","port":"9000","retries":null}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	if got.Host != "example.com" || got.Port != 9000 {
+		t.Errorf("input values overridden by defaults: %+v", got)
+	}
+	if got.Retries != nil {
+		t.Errorf("Retries = %v, want nil for explicit null", *got.Retries)
+	}
+}
+
+func TestDefaults_Validated(t *testing.T) {
+	type BadDefault struct {
+		Port int `json:"port" default:"70000" validate:"port"`
+	}
+
+	_, err := model.ParseInto[BadDefault]([]byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "between 1 and 65535") {
+		t.Errorf("ParseInto() error = %v, want port validation error", err)
+	}
+}
+
+func TestDefaults_CoercionError(t *testing.T) {
+	type BadDefault struct {
+		Port int `json:"port" default:"eighty"`
+	}
+
+	_, err := model.ParseInto[BadDefault]([]byte(`{}`))
+	if err == nil {
+		t.Error("ParseInto() expected error for uncoercible default")
+	}
+}
+
+func TestDefaults_InSlices(t *testing.T) {
+	got, err := model.ParseInto[[]DefaultsServer]([]byte(`[{"port":1},{}]`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0].Port != 1 || got[1].Port != 8080 || got[0].Host != "localhost" {
+		t.Errorf("ParseInto() = %+v, want defaults in each element", got)
+	}
+}
+
+func TestDefaults_FormAndCSV(t *testing.T) {
+	form, err := model.ParseForm[DefaultsServer](url.Values{"host": {"example.com"}, "port": {""}})
+	if err != nil {
+		t.Fatalf("ParseForm() error = %v", err)
+	}
+	if form.Host != "example.com" || form.Port != 8080 {
+		t.Errorf("ParseForm() = %+v, want default port for blank value", form)
+	}
+
+	rows, err := model.ParseCSV[DefaultsServer]([]byte("host,port\nexample.com,\n"))
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].Port != 8080 || rows[0].Timeout != 30*time.Second {
+		t.Errorf("ParseCSV() = %+v, want defaults for empty and missing cells", rows)
+	}
+}
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"errors"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -175,3 +177,50 @@ func TestStructureDepth_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// TestStructureDepth_ParseError verifies depth violations are reported as ParseErrors with a path
+func TestStructureDepth_ParseError(t *testing.T) {
+	orig := model.GetMaxStructureDepth()
+	defer model.SetMaxStructureDepth(orig)
+	model.SetMaxStructureDepth(3)
+
+	t.Run("ParseInto names the offending path", func(t *testing.T) {
+		data := []byte(`{"a":{"b":[{"c":{"d":1}}]}}`)
+		_, err := model.ParseInto[map[string]interface{}](data)
+
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+		}
+		if parseErr.Field != "a.b[0]" {
+			t.Errorf("Field = %q, want %q", parseErr.Field, "a.b[0]")
+		}
+		if !strings.Contains(parseErr.Message, "structure depth") {
+			t.Errorf("unexpected message: %s", parseErr.Message)
+		}
+	})
+
+	t.Run("CoerceValue rejects deeply nested values", func(t *testing.T) {
+		var value interface{} = "leaf"
+		for i := 0; i < 10; i++ {
+			value = map[string]interface{}{"next": value}
+		}
+
+		_, err := model.CoerceValue(value, reflect.TypeOf(map[string]interface{}{}), "Root")
+
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+		}
+		if parseErr.Field != "Root.next.next.next" {
+			t.Errorf("Field = %q, want %q", parseErr.Field, "Root.next.next.next")
+		}
+	})
+
+	t.Run("CoerceValue accepts values within the limit", func(t *testing.T) {
+		value := map[string]interface{}{"a": map[string]interface{}{"b": 1}}
+		if _, err := model.CoerceValue(value, reflect.TypeOf(map[string]map[string]int{}), "Root"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// BaseEntity is embedded into other structs and has its fields promoted
+type BaseEntity struct {
+	ID        int       `json:"id" validate:"required,min=1"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Audit is embedded by pointer
+type Audit struct {
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
+
+// Customer embeds BaseEntity by value and Audit by pointer
+type Customer struct {
+	BaseEntity
+	*Audit
+	Name string `json:"name" validate:"required"`
+}
+
+// TaggedEmbed embeds a struct under an explicit key, which disables promotion
+type TaggedEmbed struct {
+	BaseEntity `json:"base"`
+	Name       string `json:"name"`
+}
+
+// ShadowingEmbed declares a field that shadows a promoted field of the same key
+type ShadowingEmbed struct {
+	BaseEntity
+	ID string `json:"id"`
+}
+
+// OnlyEmbedded has no rules of its own; all validation comes from the embedded struct
+type OnlyEmbedded struct {
+	BaseEntity
+}
+
+func TestEmbedded_PromotedFields(t *testing.T) {
+	// Coercion ("id" as string) forces the map-based coercion path
+	inputs := []string{
+		`{"id":7,"created_at":"2024-01-01T00:00:00Z","updated_by":"admin","name":"Acme"}`,
+		`{"id":"7","created_at":"2024-01-01T00:00:00Z","updated_by":"admin","name":"Acme"}`,
+	}
+
+	for _, input := range inputs {
+		customer, err := model.ParseInto[Customer]([]byte(input))
+		if err != nil {
+			t.Fatalf("ParseInto() unexpected error = %v", err)
+		}
+		if customer.ID != 7 {
+			t.Errorf("ID = %d, want 7", customer.ID)
+		}
+		if customer.CreatedAt.IsZero() {
+			t.Error("CreatedAt should be populated from promoted key")
+		}
+		if customer.Audit == nil || customer.UpdatedBy != "admin" {
+			t.Errorf("embedded pointer not populated: %+v", customer.Audit)
+		}
+		if customer.Name != "Acme" {
+			t.Errorf("Name = %q, want Acme", customer.Name)
+		}
+	}
+}
+
+func TestEmbedded_PromotedValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"missing promoted id", `{"updated_by":"admin","name":"Acme"}`, "ID"},
+		{"missing promoted id with coercion", `{"id":"0","updated_by":"admin","name":"Acme"}`, "ID"},
+		{"missing field on embedded pointer", `{"id":"1","updated_by":"","name":"Acme"}`, "UpdatedBy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Customer]([]byte(tt.input))
+			if err == nil {
+				t.Fatal("ParseInto() expected validation error")
+			}
+
+			var errs model.ErrorList
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected ErrorList, got %T: %v", err, err)
+			}
+			if _, ok := errs.GroupByField()[tt.field]; !ok {
+				t.Errorf("expected error on field %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+func TestEmbedded_TaggedNotPromoted(t *testing.T) {
+	result, err := model.ParseInto[TaggedEmbed]([]byte(`{"id":"5","base":{"id":"9"},"name":"x"}`))
+	if err != nil {
+		t.Fatalf("ParseInto() unexpected error = %v", err)
+	}
+	if result.ID != 9 {
+		t.Errorf("ID = %d, want 9 (from nested base key, not promoted)", result.ID)
+	}
+}
+
+func TestEmbedded_ShallowFieldWins(t *testing.T) {
+	result, err := model.ParseInto[ShadowingEmbed]([]byte(`{"id":"abc","created_at":1700000000}`))
+	if err != nil {
+		t.Fatalf("ParseInto() unexpected error = %v", err)
+	}
+	if result.ID != "abc" {
+		t.Errorf("outer ID = %q, want abc", result.ID)
+	}
+	if result.BaseEntity.ID != 0 {
+		t.Errorf("shadowed embedded ID = %d, want 0", result.BaseEntity.ID)
+	}
+}
+
+func TestEmbedded_ValidateRepeatedly(t *testing.T) {
+	// Repeated calls exercise the cached fast-path, which must account for embedded rules
+	for i := 0; i < 3; i++ {
+		value := OnlyEmbedded{}
+		err := model.Validate(&value)
+		if err == nil || !strings.Contains(err.Error(), "ID") {
+			t.Fatalf("call %d: Validate() error = %v, want error on ID", i, err)
+		}
+	}
+}
+
+func TestNestedOnlyRules_ValidateRepeatedly(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name" validate:"required"`
+	}
+	type Outer struct {
+		Inner Inner `json:"inner"`
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := model.ParseInto[Outer]([]byte(`{"inner":{}}`)); err == nil {
+			t.Fatalf("call %d: expected nested validation error", i)
+		}
+	}
+}
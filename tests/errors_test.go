@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+func sampleErrorList() model.ErrorList {
+	return model.ErrorList{
+		model.NewValidationError("ID", 0, "required", "field is required"),
+		model.NewParseError("Age", "abc", "int", "cannot parse"),
+		model.NewValidationError("ID", 0, "min", "value must be at least 1"),
+		model.NewValidationErrorWithPath("Street", "User.Address.Street", "", "required", "field is required"),
+		model.NewValidationError("Email", "nope", "email", "invalid email address format"),
+	}
+}
+
+func TestErrorList_First(t *testing.T) {
+	errs := sampleErrorList()
+	if first := errs.First(); first != errs[0] {
+		t.Errorf("First() = %v, want %v", first, errs[0])
+	}
+
+	var empty model.ErrorList
+	if first := empty.First(); first != nil {
+		t.Errorf("First() on empty list = %v, want nil", first)
+	}
+}
+
+func TestErrorList_FilterByRule(t *testing.T) {
+	required := sampleErrorList().FilterByRule("required")
+	if len(required) != 2 {
+		t.Fatalf("FilterByRule(required) returned %d errors, want 2", len(required))
+	}
+	var ve *model.ValidationError
+	if !errors.As(required[1], &ve) || ve.FieldPath != "User.Address.Street" {
+		t.Errorf("FilterByRule(required)[1] = %v, want nested Street error", required[1])
+	}
+
+	if got := sampleErrorList().FilterByRule("unknown"); len(got) != 0 || got.AsError() != nil {
+		t.Errorf("FilterByRule(unknown) = %v, want empty list", got)
+	}
+}
+
+func TestErrorList_FieldErrors(t *testing.T) {
+	errs := sampleErrorList()
+
+	id := errs.FieldErrors("ID")
+	if len(id) != 2 || id[0].Rule != "required" || id[1].Rule != "min" {
+		t.Errorf("FieldErrors(ID) = %v, want required and min in order", id)
+	}
+	if street := errs.FieldErrors("User.Address.Street"); len(street) != 1 {
+		t.Errorf("FieldErrors(User.Address.Street) = %v, want 1 error", street)
+	}
+	if street := errs.FieldErrors("Street"); len(street) != 0 {
+		t.Errorf("FieldErrors(Street) = %v, want nested errors matched by full path only", street)
+	}
+	if age := errs.FieldErrors("Age"); len(age) != 0 {
+		t.Errorf("FieldErrors(Age) = %v, want parse errors excluded", age)
+	}
+}
+
+func TestErrorList_HelpersFromParse(t *testing.T) {
+	_, err := model.ParseInto[ValidatedUser]([]byte(`{"id":0,"username":"al","email":"bad","age":30,"name":"Al"}`))
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("ParseInto() error = %T, want ErrorList", err)
+	}
+
+	if errs.First() == nil {
+		t.Error("First() = nil, want an error")
+	}
+	if got := errs.FilterByRule("email"); len(got) != 1 {
+		t.Errorf("FilterByRule(email) = %v, want 1 error", got)
+	}
+	if got := errs.FieldErrors("Username"); len(got) != 1 || got[0].Rule != "min" {
+		t.Errorf("FieldErrors(Username) = %v, want min error", got)
+	}
+}
+
+// TestErrorList_Formatters verifies the built-in formatters and SetErrorListFormatter
+func TestErrorList_Formatters(t *testing.T) {
+	errs := sampleErrorList()[1:4]
+
+	if got, want := errs.Error(), `multiple errors: parse error on field "Age": cannot parse; `+
+		`validation error on field "ID": value must be at least 1; `+
+		`validation error on field "User.Address.Street": field is required`; got != want {
+		t.Errorf("default Error() = %q, want %q", got, want)
+	}
+
+	lines := errs.FormatWith(model.LineErrorListFormatter)
+	if strings.Count(lines, "\n") != 2 || !strings.HasPrefix(lines, `parse error on field "Age"`) {
+		t.Errorf("LineErrorListFormatter = %q", lines)
+	}
+
+	compact := errs.FormatWith(model.CompactErrorListFormatter)
+	if want := "Age: cannot parse; ID: value must be at least 1; User.Address.Street: field is required"; compact != want {
+		t.Errorf("CompactErrorListFormatter = %q, want %q", compact, want)
+	}
+
+	model.SetErrorListFormatter(func(errs model.ErrorList) string {
+		return fmt.Sprintf("%d problems", len(errs))
+	})
+	defer model.SetErrorListFormatter(nil)
+
+	if got := errs.Error(); got != "3 problems" {
+		t.Errorf("custom Error() = %q", got)
+	}
+	if got := (model.ErrorList{}).Error(); got != "" {
+		t.Errorf("empty list = %q, want empty", got)
+	}
+}
+
+func TestErrorList_HTTPStatus(t *testing.T) {
+	model.SetRuleHTTPStatus("unique", http.StatusConflict)
+	model.SetRuleHTTPStatus("after", http.StatusUnprocessableEntity)
+	defer model.SetRuleHTTPStatus("unique", 0)
+	defer model.SetRuleHTTPStatus("after", 0)
+
+	unique := model.NewValidationError("Email", "a@x.io", "unique", "email already registered")
+	after := model.NewValidationError("EndsAt", "2024-01-01", "after", "must be after StartsAt")
+	required := model.NewValidationError("Name", "", "required", "field is required")
+	parseErr := model.NewParseError("Age", "old", "int", "cannot parse")
+	warning := model.NewValidationError("Nickname", "x", "min", "too short")
+	warning.Severity = model.SeverityWarning
+
+	tests := []struct {
+		name string
+		errs model.ErrorList
+		want int
+	}{
+		{"empty", nil, http.StatusOK},
+		{"unmapped rule", model.ErrorList{required}, http.StatusBadRequest},
+		{"parse error", model.ErrorList{parseErr}, http.StatusBadRequest},
+		{"mapped rule", model.ErrorList{unique, unique}, http.StatusConflict},
+		{"warnings ignored", model.ErrorList{warning, after}, http.StatusUnprocessableEntity},
+		{"only warnings", model.ErrorList{warning}, http.StatusOK},
+		{"disagreeing statuses", model.ErrorList{unique, after}, http.StatusBadRequest},
+		{"mapped and parse error", model.ErrorList{after, parseErr}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.errs.HTTPStatus(); got != tt.want {
+				t.Errorf("HTTPStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
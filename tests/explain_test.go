@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type ExplainItem struct {
+	SKU string `json:"sku" validate:"required,regex='^[a-z]{2,8}$'"`
+}
+
+type ExplainNode struct {
+	Name     string        `json:"name" validate:"required"`
+	Children []ExplainNode `json:"children" validate:"max=3"`
+}
+
+type ExplainOrder struct {
+	ID      int                     `json:"id" validate:"required,min=1" groups:"update"`
+	Note    string                  `json:"note" validate:"contains=', ',min=2,warn,required_if=ID 5"`
+	Items   []ExplainItem           `json:"items"`
+	ByCode  map[string]*ExplainItem `json:"by_code"`
+	Tree    *ExplainNode            `json:"tree"`
+	Comment string                  `json:"comment"`
+}
+
+func TestExplain(t *testing.T) {
+	got := model.Explain[ExplainOrder]()
+
+	skuRules := []model.RuleInfo{
+		{Name: "required"},
+		{Name: "regex", Param: "^[a-z]{2,8}$", Value: "^[a-z]{2,8}$"},
+	}
+	want := map[string][]model.RuleInfo{
+		"ID": {
+			{Name: "required", Groups: []string{"update"}},
+			{Name: "min", Param: "1", Value: 1.0, Groups: []string{"update"}},
+		},
+		"Note": {
+			{Name: "contains", Param: ", ", Value: ", "},
+			{Name: "min", Param: "2", Value: 2.0, Severity: model.SeverityWarning},
+			{Name: "required_if", Param: "ID 5", Value: "ID 5"},
+		},
+		"Items[].SKU":   skuRules,
+		"ByCode[].SKU":  skuRules,
+		"Tree.Name":     {{Name: "required"}},
+		"Tree.Children": {{Name: "max", Param: "3", Value: 3.0}}, // recursive types are walked once
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Explain() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestExplain_NoRules(t *testing.T) {
+	type Plain struct {
+		Name string `json:"name"`
+	}
+
+	if got := model.Explain[Plain](); len(got) != 0 {
+		t.Errorf("Explain() = %v, want empty map", got)
+	}
+	if got := model.Explain[int](); got == nil || len(got) != 0 {
+		t.Errorf("Explain[int]() = %v, want empty non-nil map", got)
+	}
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type PathCircuitBreaker struct {
+	FailureThreshold int `json:"failure_threshold" validate:"min=1"`
+}
+
+type PathService struct {
+	Name           string             `json:"name" validate:"required"`
+	CircuitBreaker PathCircuitBreaker `json:"circuit_breaker"`
+}
+
+type PathExternalServices struct {
+	Services []PathService          `json:"services"`
+	ByName   map[string]PathService `json:"by_name"`
+	Primary  *PathService           `json:"primary"`
+}
+
+type PathConfig struct {
+	External PathExternalServices `json:"external_services"`
+}
+
+// errorPaths returns the sorted FieldPath of every validation error in err
+func errorPaths(t *testing.T, err error) []string {
+	t.Helper()
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ErrorList, got %T: %v", err, err)
+	}
+	var paths []string
+	for _, ve := range errs.ValidationErrors() {
+		paths = append(paths, ve.FieldPath)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestFieldPath_NestedCollections(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantPaths []string
+	}{
+		{
+			"slice element",
+			`{"external_services":{"services":[{"name":"a","circuit_breaker":{"failure_threshold":1}},{"name":"b","circuit_breaker":{"failure_threshold":0}}]}}`,
+			[]string{"External.Services[1].CircuitBreaker.FailureThreshold"},
+		},
+		{
+			"slice element with coercion",
+			`{"external_services":{"services":[{"circuit_breaker":{"failure_threshold":"0"}}]}}`,
+			[]string{"External.Services[0].CircuitBreaker.FailureThreshold", "External.Services[0].Name"},
+		},
+		{
+			"map value",
+			`{"external_services":{"by_name":{"email":{"name":"x","circuit_breaker":{"failure_threshold":0}}}}}`,
+			[]string{"External.ByName[email].CircuitBreaker.FailureThreshold"},
+		},
+		{
+			"map value with coercion",
+			`{"external_services":{"by_name":{"email":{"name":"x","circuit_breaker":{"failure_threshold":"0"}}}}}`,
+			[]string{"External.ByName[email].CircuitBreaker.FailureThreshold"},
+		},
+		{
+			"pointer",
+			`{"external_services":{"primary":{"name":"x","circuit_breaker":{"failure_threshold":0}}}}`,
+			[]string{"External.Primary.CircuitBreaker.FailureThreshold"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[PathConfig]([]byte(tt.input))
+			if got := errorPaths(t, err); !reflect.DeepEqual(got, tt.wantPaths) {
+				t.Errorf("error paths = %v, want %v", got, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestFieldPath_ValidateAndReport(t *testing.T) {
+	cfg := PathConfig{External: PathExternalServices{
+		Services: []PathService{{Name: "a", CircuitBreaker: PathCircuitBreaker{FailureThreshold: 3}}, {Name: "b"}},
+		ByName:   map[string]PathService{"sms": {CircuitBreaker: PathCircuitBreaker{FailureThreshold: 1}}},
+	}}
+
+	err := model.Validate(&cfg)
+	want := []string{"External.ByName[sms].Name", "External.Services[1].CircuitBreaker.FailureThreshold"}
+	if got := errorPaths(t, err); !reflect.DeepEqual(got, want) {
+		t.Fatalf("error paths = %v, want %v", got, want)
+	}
+
+	var errs model.ErrorList
+	errors.As(err, &errs)
+	for _, fieldErr := range errs.ToStructuredReport().Errors {
+		if fieldErr.FieldPath == "External.Services[1].CircuitBreaker.FailureThreshold" && fieldErr.Field != "FailureThreshold" {
+			t.Errorf("report Field = %q, want leaf name FailureThreshold", fieldErr.Field)
+		}
+	}
+}
+
+func TestParseInto_MapOfScalarsCoerced(t *testing.T) {
+	type Limits struct {
+		Quotas map[string]int `json:"quotas"`
+		Ports  map[int]string `json:"ports"`
+		Count  int            `json:"count"`
+	}
+
+	// A string count forces the map-based coercion path
+	got, err := model.ParseInto[Limits]([]byte(`{"quotas":{"a":"1","b":2},"ports":{"80":"http"},"count":"3"}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+	if got.Quotas["a"] != 1 || got.Quotas["b"] != 2 || got.Ports[80] != "http" || got.Count != 3 {
+		t.Errorf("ParseInto() = %+v, want coerced map values and keys", got)
+	}
+
+	_, err = model.ParseInto[Limits]([]byte(`{"quotas":{"a":"lots"},"count":"3"}`))
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != "Quotas[a]" {
+		t.Errorf("ParseInto() error = %v, want ParseError for Quotas[a]", err)
+	}
+}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type SearchForm struct {
+	Query    string    `form:"q" validate:"required"`
+	Page     int       `form:"page" validate:"min=1"`
+	Tags     []string  `form:"tag"`
+	IDs      []int     `json:"id"`
+	Exact    bool      `json:"exact"`
+	MaxPrice *float64  `form:"max_price"`
+	Ignored  string    `form:"-" json:"ignored"`
+	Sort     [2]string `form:"sort"`
+}
+
+func TestParseForm(t *testing.T) {
+	values, err := url.ParseQuery("q=golang&page=2&tag=web&tag=api&id=1&id=2&exact=on&max_price=9.5&ignored=x&sort=name&sort=desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search, err := model.ParseForm[SearchForm](values)
+	if err != nil {
+		t.Fatalf("ParseForm() unexpected error = %v", err)
+	}
+
+	if search.Query != "golang" || search.Page != 2 {
+		t.Errorf("Query, Page = %q, %d, want golang, 2", search.Query, search.Page)
+	}
+	if strings.Join(search.Tags, ",") != "web,api" {
+		t.Errorf("Tags = %v, want [web api]", search.Tags)
+	}
+	if len(search.IDs) != 2 || search.IDs[0] != 1 || search.IDs[1] != 2 {
+		t.Errorf("IDs = %v, want [1 2]", search.IDs)
+	}
+	if !search.Exact {
+		t.Error("Exact = false, want true for \"on\"")
+	}
+	if search.MaxPrice == nil || *search.MaxPrice != 9.5 {
+		t.Errorf("MaxPrice = %v, want 9.5", search.MaxPrice)
+	}
+	if search.Ignored != "" {
+		t.Errorf("Ignored = %q, want field excluded by form:\"-\"", search.Ignored)
+	}
+	if search.Sort != [2]string{"name", "desc"} {
+		t.Errorf("Sort = %v, want [name desc]", search.Sort)
+	}
+}
+
+func TestParseForm_EmptyAndRepeatedValues(t *testing.T) {
+	values := url.Values{
+		"q":    {"first", "second"},
+		"page": {""},
+	}
+
+	_, err := model.ParseForm[SearchForm](values)
+	if err == nil || !strings.Contains(err.Error(), "Page") {
+		t.Fatalf("ParseForm() error = %v, want min error for blank page", err)
+	}
+
+	values.Set("page", "1")
+	search, err := model.ParseForm[SearchForm](values)
+	if err != nil {
+		t.Fatalf("ParseForm() unexpected error = %v", err)
+	}
+	if search.Query != "first" {
+		t.Errorf("Query = %q, want first value", search.Query)
+	}
+}
+
+func TestParseForm_Errors(t *testing.T) {
+	values := url.Values{
+		"page": {"two"},
+		"id":   {"1", "x"},
+	}
+
+	_, err := model.ParseForm[SearchForm](values)
+	if err == nil {
+		t.Fatal("ParseForm() expected error, got nil")
+	}
+	msg := err.Error()
+	for _, want := range []string{"Query", "Page", "IDs"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q missing field %s", msg, want)
+		}
+	}
+
+	if _, err := model.ParseForm[map[string]string](values); err == nil {
+		t.Error("ParseForm() into non-struct type should fail")
+	}
+	if _, err := model.ParseForm[any](values); err == nil || !strings.Contains(err.Error(), "must be a struct") {
+		t.Errorf("ParseForm() into interface type error = %v, want must be a struct", err)
+	}
+}
@@ -52,6 +52,36 @@ func TestDetectFormat(t *testing.T) {
 			input:    []byte("  \n  {\n  \"name\": \"John\"\n}"),
 			expected: model.FormatJSON,
 		},
+		{
+			name:     "JSONC with line comment",
+			input:    []byte("{\n  // service name\n  \"name\": \"api\"\n}"),
+			expected: model.FormatJSONC,
+		},
+		{
+			name:     "JSONC with trailing comma",
+			input:    []byte(`{"hosts": ["a", "b",],}`),
+			expected: model.FormatJSONC,
+		},
+		{
+			name:     "JSONC with leading block comment",
+			input:    []byte("/* generated */\n{\"name\": \"api\"}"),
+			expected: model.FormatJSONC,
+		},
+		{
+			name:     "JSON with comment markers inside strings",
+			input:    []byte(`{"url": "https://example.com/*", "note": "a,]"}`),
+			expected: model.FormatJSON,
+		},
+		{
+			name:     "XML document",
+			input:    []byte("<?xml version=\"1.0\"?>\n<user><name>John</name></user>"),
+			expected: model.FormatXML,
+		},
+		{
+			name:     "YAML with mostly nested mapping keys",
+			input:    []byte("server:\n  tls:\n    options:\n      min_version: '1.3'"),
+			expected: model.FormatYAML,
+		},
 		{
 			name:     "YAML list",
 			input:    []byte("hosts:\n  - api.example.com\n  - cdn.example.com"),
@@ -62,6 +92,61 @@ func TestDetectFormat(t *testing.T) {
 			input:    []byte("simple string"),
 			expected: model.FormatJSON,
 		},
+		{
+			name:     "JSON with UTF-8 BOM",
+			input:    []byte("\xEF\xBB\xBF{\"name\": \"John\"}"),
+			expected: model.FormatJSON,
+		},
+		{
+			name:     "YAML with UTF-8 BOM",
+			input:    []byte("\xEF\xBB\xBFname: John\nage: 30"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "JSON with BOM and leading whitespace",
+			input:    []byte("\xEF\xBB\xBF \r\n\t[1, 2, 3]"),
+			expected: model.FormatJSON,
+		},
+		{
+			name:     "YAML with leading comment",
+			input:    []byte("# service config\nname: John"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "YAML comment before flow mapping",
+			input:    []byte("# generated\n{name: John}"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "YAML document separator after whitespace",
+			input:    []byte("  \n---\n- a\n- b"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "YAML document separator before flow mapping",
+			input:    []byte("--- {\"name\": \"John\"}"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "YAML directive",
+			input:    []byte("%YAML 1.2\n---\nname: John"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "Comment only",
+			input:    []byte("# nothing here"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "YAML with CRLF line endings",
+			input:    []byte("\r\nname: John\r\nage: 30\r\n"),
+			expected: model.FormatYAML,
+		},
+		{
+			name:     "Negative number is not a document separator",
+			input:    []byte("---5"),
+			expected: model.FormatJSON,
+		},
 	}
 
 	for _, tt := range tests {
@@ -74,6 +159,29 @@ func TestDetectFormat(t *testing.T) {
 	}
 }
 
+func TestParseInto_ByteOrderMark(t *testing.T) {
+	type person struct {
+		Name string `json:"name" yaml:"name"`
+	}
+
+	inputs := map[string]string{
+		"json": "\xEF\xBB\xBF{\"name\": \"John\"}",
+		"yaml": "\xEF\xBB\xBF# person\nname: John\n",
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			p, err := model.ParseInto[person]([]byte(input))
+			if err != nil {
+				t.Fatalf("ParseInto() unexpected error = %v", err)
+			}
+			if p.Name != "John" {
+				t.Errorf("Name = %q, want John", p.Name)
+			}
+		})
+	}
+}
+
 func TestGetParser(t *testing.T) {
 	tests := []struct {
 		format   model.Format
@@ -81,6 +189,8 @@ func TestGetParser(t *testing.T) {
 	}{
 		{model.FormatJSON, model.FormatJSON},
 		{model.FormatYAML, model.FormatYAML},
+		{model.FormatJSONC, model.FormatJSONC},
+		{model.FormatXML, model.FormatXML},
 	}
 
 	for _, tt := range tests {
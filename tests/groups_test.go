@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// UserRequest is shared by create and update endpoints
+type UserRequest struct {
+	ID       int          `json:"id" validate:"required,min=1" groups:"update"`
+	Email    string       `json:"email" validate:"required,email" groups:"create, update"`
+	Password string       `json:"password" validate:"required,min=8" groups:"create"`
+	Name     string       `json:"name" validate:"max=10"`
+	Address  *UserAddress `json:"address"`
+}
+
+type UserAddress struct {
+	City string `json:"city" validate:"required" groups:"create"`
+}
+
+func TestValidateGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    UserRequest
+		groups     []string
+		wantFields []string
+	}{
+		{"no group skips grouped fields", UserRequest{}, nil, nil},
+		{"ungrouped always runs", UserRequest{Name: "a very long name"}, nil, []string{"Name"}},
+		{"create", UserRequest{Address: &UserAddress{}}, []string{"create"}, []string{"Address.City", "Email", "Password"}},
+		{"update", UserRequest{Password: "x"}, []string{"update"}, []string{"Email", "ID"}},
+		{"multiple groups", UserRequest{Name: "a very long name"}, []string{"create", "update"}, []string{"Email", "ID", "Name", "Password"}},
+		{"unknown group", UserRequest{}, []string{"delete"}, nil},
+		{"valid create", UserRequest{Email: "a@b.co", Password: "secret123"}, []string{"create"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ValidateGroups(&tt.request, tt.groups...)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Errorf("ValidateGroups() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var errs model.ErrorList
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected ErrorList, got %T: %v", err, err)
+			}
+			var fields []string
+			for field := range errs.GroupByField() {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			if len(fields) != len(tt.wantFields) {
+				t.Fatalf("error fields = %v, want %v", fields, tt.wantFields)
+			}
+			for i := range fields {
+				if fields[i] != tt.wantFields[i] {
+					t.Errorf("error fields = %v, want %v", fields, tt.wantFields)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestParseInto_SkipsGroupedRules(t *testing.T) {
+	// Coerced input ("id" as string) exercises the map-based path as well
+	inputs := []string{`{"name":"Al"}`, `{"id":"0","name":"Al","address":{}}`}
+
+	for _, input := range inputs {
+		req, err := model.ParseInto[UserRequest]([]byte(input))
+		if err != nil {
+			t.Fatalf("ParseInto(%s) unexpected error = %v", input, err)
+		}
+		if err := model.ValidateGroups(&req, "update"); err == nil {
+			t.Errorf("ValidateGroups(update) on %s expected error", input)
+		}
+	}
+}
+
+func TestParseValidationTags_Groups(t *testing.T) {
+	validation := model.ParseValidationTags(reflect.TypeOf(UserRequest{}))
+	for _, field := range validation.Fields {
+		if field.FieldName == "Email" {
+			if len(field.Groups) != 2 || field.Groups[0] != "create" || field.Groups[1] != "update" {
+				t.Errorf("Email groups = %v, want [create update]", field.Groups)
+			}
+			return
+		}
+	}
+	t.Error("Email field validation not found")
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// InlineServiceConfig nests anonymous structs two and three levels deep, as config
+// structs often do
+type InlineServiceConfig struct {
+	Name     string `json:"name" yaml:"name" validate:"required"`
+	Database struct {
+		Host string `json:"host" yaml:"host" validate:"required"`
+		Port int    `json:"port" yaml:"port" validate:"port"`
+		TLS  struct {
+			Enabled bool   `json:"enabled" yaml:"enabled"`
+			Cert    string `json:"cert" yaml:"cert" validate:"required"`
+			Options struct {
+				MinVersion string `json:"min_version" yaml:"min_version" validate:"startswith=1."`
+			} `json:"options" yaml:"options"`
+		} `json:"tls" yaml:"tls"`
+	} `json:"database" yaml:"database"`
+	Replicas []struct {
+		Host string `json:"host" yaml:"host" validate:"required"`
+	} `json:"replicas" yaml:"replicas"`
+}
+
+// wantFieldPaths checks that err holds validation errors for exactly the given paths
+func wantFieldPaths(t *testing.T, err error, paths ...string) {
+	t.Helper()
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		var single *model.ValidationError
+		if !errors.As(err, &single) {
+			t.Fatalf("expected validation errors on %v, got %v", paths, err)
+		}
+		errs = model.ErrorList{single}
+	}
+
+	got := map[string]bool{}
+	for _, validationErr := range errs.ValidationErrors() {
+		got[validationErr.FieldPath] = true
+	}
+	if len(got) != len(paths) {
+		t.Errorf("got errors on %v, want %v", got, paths)
+	}
+	for _, path := range paths {
+		if !got[path] {
+			t.Errorf("missing validation error on %s in %v", path, err)
+		}
+	}
+}
+
+func TestParseInto_InlineStructs(t *testing.T) {
+	valid := []struct {
+		name  string
+		input string
+	}{
+		{"JSON", `{"name": "api", "database": {"host": "db", "port": 5432,
+			"tls": {"enabled": true, "cert": "/etc/tls.pem", "options": {"min_version": "1.3"}}},
+			"replicas": [{"host": "replica-1"}]}`},
+		{"JSON with coercion", `{"name": "api", "database": {"host": "db", "port": "5432",
+			"tls": {"enabled": "true", "cert": "/etc/tls.pem", "options": {"min_version": "1.3"}}},
+			"replicas": [{"host": "replica-1"}]}`},
+		{"YAML", "name: api\ndatabase:\n  host: db\n  port: 5432\n  tls:\n    enabled: true\n    cert: /etc/tls.pem\n    options:\n      min_version: '1.3'\nreplicas:\n  - host: replica-1\n"},
+	}
+
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := model.ParseInto[InlineServiceConfig]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() error = %v", err)
+			}
+			if cfg.Database.Port != 5432 || !cfg.Database.TLS.Enabled ||
+				cfg.Database.TLS.Options.MinVersion != "1.3" || cfg.Replicas[0].Host != "replica-1" {
+				t.Errorf("got %+v", cfg)
+			}
+		})
+	}
+}
+
+func TestParseInto_InlineStructValidation(t *testing.T) {
+	invalid := []struct {
+		name  string
+		input string
+	}{
+		{"JSON", `{"name": "api", "database": {"host": "db", "port": 0,
+			"tls": {"cert": "", "options": {"min_version": "2.0"}}}, "replicas": [{"host": ""}]}`},
+		{"JSON with coercion", `{"name": "api", "database": {"host": "db", "port": "0",
+			"tls": {"enabled": "false", "cert": "", "options": {"min_version": 2.0}}}, "replicas": [{"host": ""}]}`},
+		{"YAML", "name: api\ndatabase:\n  host: db\n  port: 0\n  tls:\n    cert: ''\n    options:\n      min_version: '2.0'\nreplicas:\n  - host: ''\n"},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[InlineServiceConfig]([]byte(tt.input))
+			wantFieldPaths(t, err, "Database.Port", "Database.TLS.Cert",
+				"Database.TLS.Options.MinVersion", "Replicas[0].Host")
+		})
+	}
+
+	// Validate reaches the same fields on a value built in code
+	var cfg InlineServiceConfig
+	cfg.Name = "api"
+	cfg.Database.Host = "db"
+	cfg.Database.Port = 5432
+	cfg.Database.TLS.Options.MinVersion = "0.9"
+	wantFieldPaths(t, model.Validate(&cfg), "Database.TLS.Cert", "Database.TLS.Options.MinVersion")
+}
+
+func TestParseInto_InlineStructYAMLDetection(t *testing.T) {
+	// Most lines only open nested mappings; the input must still be detected as YAML
+	raw := []byte("database:\n  tls:\n    options:\n      min_version: '2.0'\n")
+	if got := model.DetectFormat(raw); got != model.FormatYAML {
+		t.Fatalf("DetectFormat() = %v, want FormatYAML", got)
+	}
+
+	_, err := model.ParseInto[InlineServiceConfig](raw)
+	wantFieldPaths(t, err, "Name", "Database.Host", "Database.Port", "Database.TLS.Cert", "Database.TLS.Options.MinVersion")
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// EditorSettings is parsed from a JSONC settings file
+type EditorSettings struct {
+	Theme    string   `json:"theme" validate:"required"`
+	TabSize  int      `json:"tab_size" validate:"min=1"`
+	Rulers   []int    `json:"rulers"`
+	Excludes []string `json:"excludes"`
+}
+
+const editorSettingsJSONC = `{
+	// Colour theme
+	"theme": "dark", /* overridden per workspace */
+	"tab_size": "4",
+	"rulers": [80, 120,],
+	"excludes": [
+		"**/node_modules", // dependencies
+		"/* not a comment */",
+	],
+}`
+
+func TestParseInto_JSONC(t *testing.T) {
+	settings, err := model.ParseInto[EditorSettings]([]byte(editorSettingsJSONC))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	if settings.Theme != "dark" || settings.TabSize != 4 {
+		t.Errorf("got theme=%q tab_size=%d, want dark and 4", settings.Theme, settings.TabSize)
+	}
+	if len(settings.Rulers) != 2 || settings.Rulers[1] != 120 {
+		t.Errorf("Rulers = %v, want [80 120]", settings.Rulers)
+	}
+	want := []string{"**/node_modules", "/* not a comment */"}
+	if len(settings.Excludes) != 2 || settings.Excludes[0] != want[0] || settings.Excludes[1] != want[1] {
+		t.Errorf("Excludes = %q, want %q", settings.Excludes, want)
+	}
+}
+
+func TestParseIntoWithFormat_JSONC(t *testing.T) {
+	raw := []byte("{\"theme\": \"light\", \"tab_size\": 2, // indent\n}")
+
+	settings, err := model.ParseIntoWithFormat[EditorSettings](raw, model.FormatJSONC)
+	if err != nil {
+		t.Fatalf("ParseIntoWithFormat() error = %v", err)
+	}
+	if settings.Theme != "light" || settings.TabSize != 2 {
+		t.Errorf("got %+v", settings)
+	}
+
+	// Plain JSON rejects the same input
+	if _, err := model.ParseIntoWithFormat[EditorSettings](raw, model.FormatJSON); err == nil {
+		t.Error("expected FormatJSON to reject comments and trailing commas")
+	}
+}
+
+func TestParseInto_JSONCValidation(t *testing.T) {
+	raw := []byte(`{
+		// theme is required
+		"tab_size": 0,
+	}`)
+
+	_, err := model.ParseInto[EditorSettings](raw)
+	var errs model.ErrorList
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("expected 2 validation errors, got %v", err)
+	}
+}
+
+func TestJSONCParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated block comment", `{"theme": "dark"} /* open`},
+		{"comma without value", `{"theme": "dark",, }`},
+		{"leading comma", `[, 1]`},
+	}
+
+	parser := model.GetParser(model.FormatJSONC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parser.Parse([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), "json parse error") {
+				t.Errorf("Parse(%q) error = %v, want json parse error", tt.input, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// NumberRecord mixes a string-encoded field, forcing the coercion path, with large numbers
+type NumberRecord struct {
+	Kind    int                    `json:"kind"`
+	ID      int64                  `json:"id"`
+	Serial  uint64                 `json:"serial"`
+	Label   string                 `json:"label"`
+	Any     interface{}            `json:"any"`
+	Extra   map[string]interface{} `json:"extra"`
+	Balance *big.Int               `json:"balance"`
+}
+
+// TestJSONNumber_LargeIntegersKeepPrecision verifies integers beyond 2^53 survive coercion
+func TestJSONNumber_LargeIntegersKeepPrecision(t *testing.T) {
+	data := []byte(`{
+		"kind": "1",
+		"id": 9007199254740993,
+		"serial": 18446744073709551615,
+		"label": 12345678901234567890,
+		"balance": 123456789012345678901234567890
+	}`)
+
+	record, err := model.ParseInto[NumberRecord](data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if record.ID != 9007199254740993 {
+		t.Errorf("ID = %d, want 9007199254740993", record.ID)
+	}
+	if record.Serial != 18446744073709551615 {
+		t.Errorf("Serial = %d, want 18446744073709551615", record.Serial)
+	}
+	if record.Label != "12345678901234567890" {
+		t.Errorf("Label = %q, want the literal digits", record.Label)
+	}
+	if record.Balance == nil || record.Balance.String() != "123456789012345678901234567890" {
+		t.Errorf("Balance = %v, want 123456789012345678901234567890", record.Balance)
+	}
+}
+
+// TestJSONNumber_OutOfRange verifies integers too large for the field are rejected
+func TestJSONNumber_OutOfRange(t *testing.T) {
+	_, err := model.ParseInto[NumberRecord]([]byte(`{"kind":"1","id":99999999999999999999}`))
+
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+	}
+	if !strings.Contains(parseErr.Message, "out of range") {
+		t.Errorf("unexpected message: %s", parseErr.Message)
+	}
+}
+
+// TestJSONNumber_ExponentIntoInteger verifies exponent forms still coerce into integers
+func TestJSONNumber_ExponentIntoInteger(t *testing.T) {
+	record, err := model.ParseInto[NumberRecord]([]byte(`{"kind":"1","id":1e3,"serial":2E2}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.ID != 1000 || record.Serial != 200 {
+		t.Errorf("ID = %d, Serial = %d; want 1000, 200", record.ID, record.Serial)
+	}
+}
+
+// TestJSONNumber_InterfaceFieldsHoldFloat64 verifies interface{} values match encoding/json
+func TestJSONNumber_InterfaceFieldsHoldFloat64(t *testing.T) {
+	record, err := model.ParseInto[NumberRecord]([]byte(`{"kind":"1","any":42,"extra":{"n":1.5,"list":[1,2]}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if record.Any != float64(42) {
+		t.Errorf("Any = %#v, want float64(42)", record.Any)
+	}
+	want := map[string]interface{}{"n": 1.5, "list": []interface{}{float64(1), float64(2)}}
+	if !reflect.DeepEqual(record.Extra, want) {
+		t.Errorf("Extra = %#v, want %#v", record.Extra, want)
+	}
+}
+
+// TestJSONNumber_CoerceValue verifies json.Number inputs coerce to every numeric kind
+func TestJSONNumber_CoerceValue(t *testing.T) {
+	tests := []struct {
+		target reflect.Type
+		input  json.Number
+		want   interface{}
+	}{
+		{reflect.TypeOf(int64(0)), "9223372036854775807", int64(9223372036854775807)},
+		{reflect.TypeOf(uint8(0)), "255", uint8(255)},
+		{reflect.TypeOf(float64(0)), "0.1", 0.1},
+		{reflect.TypeOf(""), "1.50", "1.50"},
+		{reflect.TypeOf(true), "1", true},
+	}
+
+	for _, tt := range tests {
+		got, err := model.CoerceValue(tt.input, tt.target, "Value")
+		if err != nil {
+			t.Errorf("CoerceValue(%s, %s) unexpected error: %v", tt.input, tt.target, err)
+			continue
+		}
+		if reflect.ValueOf(got).Convert(tt.target).Interface() != tt.want {
+			t.Errorf("CoerceValue(%s, %s) = %#v, want %#v", tt.input, tt.target, got, tt.want)
+		}
+	}
+}
+
+// TestJSONNumber_Unmarshal verifies Unmarshal's coercion fallback keeps large integers exact
+func TestJSONNumber_Unmarshal(t *testing.T) {
+	var record NumberRecord
+	if err := model.Unmarshal([]byte(`{"kind":"1","label":9007199254740993}`), &record); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Label != "9007199254740993" {
+		t.Errorf("Label = %q, want 9007199254740993", record.Label)
+	}
+}
@@ -0,0 +1,278 @@
+package tests
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type SchemaAddress struct {
+	City string `json:"city" validate:"required,min=2,alpha"`
+	Zip  string `json:"zip" validate:"len=5,number"`
+}
+
+type SchemaCategory struct {
+	Name     string           `json:"name" validate:"required"`
+	Children []SchemaCategory `json:"children" validate:"max=10"`
+}
+
+type SchemaBase struct {
+	ID int `json:"id" validate:"required,min=1"`
+}
+
+type SchemaUser struct {
+	SchemaBase
+	Email    string         `json:"email" validate:"required,email"`
+	Age      *int           `json:"age" validate:"min=18,max=120"`
+	Home     SchemaAddress  `json:"home"`
+	Work     *SchemaAddress `json:"work" validate:"required"`
+	Tags     []string       `json:"tags" validate:"min=1,max=5"`
+	Port     int            `json:"port" default:"8080" validate:"port"`
+	Timeout  time.Duration  `json:"timeout" default:"30s"`
+	Joined   time.Time      `json:"joined"`
+	Labels   map[string]int `json:"labels"`
+	Code     string         `json:"code" validate:"regex=^[A-Z]+$,startswith=X"`
+	Category SchemaCategory `json:"category"`
+	Nickname string         `json:"nickname" validate:"min=3,warn"`
+	Secret   string         `json:"-"`
+}
+
+func generateSchema[T any](t *testing.T) map[string]interface{} {
+	t.Helper()
+	raw, err := model.JSONSchema[T]()
+	if err != nil {
+		t.Fatalf("JSONSchema() error = %v", err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("JSONSchema() produced invalid JSON: %v\n%s", err, raw)
+	}
+	return schema
+}
+
+func TestJSONSchema_Struct(t *testing.T) {
+	schema := generateSchema[SchemaUser](t)
+
+	if schema["$schema"] != "http://json-schema.org/draft-07/schema#" || schema["type"] != "object" {
+		t.Errorf("root = %v, want draft-07 object", schema)
+	}
+	if got := schema["required"]; !reflect.DeepEqual(got, []interface{}{"id", "email", "work"}) {
+		t.Errorf("required = %v, want [id email work]", got)
+	}
+
+	props := schema["properties"].(map[string]interface{})
+	if _, ok := props["Secret"]; ok {
+		t.Error(`properties include field tagged json:"-"`)
+	}
+
+	tests := []struct {
+		property string
+		want     map[string]interface{}
+	}{
+		{"id", map[string]interface{}{"type": "integer", "minimum": 1.0}},
+		{"email", map[string]interface{}{"type": "string", "format": "email"}},
+		{"age", map[string]interface{}{"type": "integer", "minimum": 18.0, "maximum": 120.0}},
+		{"home", map[string]interface{}{"$ref": "#/definitions/SchemaAddress"}},
+		{"work", map[string]interface{}{"$ref": "#/definitions/SchemaAddress"}},
+		{"tags", map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "minItems": 1.0, "maxItems": 5.0}},
+		{"port", map[string]interface{}{"type": "integer", "minimum": 1.0, "maximum": 65535.0, "default": 8080.0}},
+		{"timeout", map[string]interface{}{
+			"anyOf":   []interface{}{map[string]interface{}{"type": "string"}, map[string]interface{}{"type": "integer"}},
+			"default": "30s",
+		}},
+		{"joined", map[string]interface{}{"type": "string", "format": "date-time"}},
+		{"labels", map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "integer"}}},
+		{"code", map[string]interface{}{"type": "string", "allOf": []interface{}{
+			map[string]interface{}{"pattern": "^[A-Z]+$"},
+			map[string]interface{}{"pattern": "^X"},
+		}}},
+		{"nickname", map[string]interface{}{"type": "string"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.property, func(t *testing.T) {
+			if got := props[tt.property]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("properties[%q] = %v, want %v", tt.property, got, tt.want)
+			}
+		})
+	}
+
+	defs := schema["definitions"].(map[string]interface{})
+	wantAddress := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"city": map[string]interface{}{"type": "string", "minLength": 2.0, "pattern": "^[a-zA-Z]+$"},
+			"zip":  map[string]interface{}{"type": "string", "minLength": 5.0, "maxLength": 5.0, "pattern": "^[0-9]+$"},
+		},
+		"required": []interface{}{"city"},
+	}
+	if got := defs["SchemaAddress"]; !reflect.DeepEqual(got, wantAddress) {
+		t.Errorf("definitions[SchemaAddress] = %v, want %v", got, wantAddress)
+	}
+}
+
+func TestJSONSchema_Recursive(t *testing.T) {
+	schema := generateSchema[SchemaCategory](t)
+
+	children := schema["properties"].(map[string]interface{})["children"].(map[string]interface{})
+	if items := children["items"]; !reflect.DeepEqual(items, map[string]interface{}{"$ref": "#"}) {
+		t.Errorf("children items = %v, want reference to root", items)
+	}
+	if _, ok := schema["definitions"]; ok {
+		t.Errorf("definitions = %v, want none for a self-contained type", schema["definitions"])
+	}
+}
+
+func TestJSONSchema_NonStruct(t *testing.T) {
+	schema := generateSchema[[]SchemaAddress](t)
+
+	if schema["type"] != "array" {
+		t.Errorf("type = %v, want array", schema["type"])
+	}
+	if items := schema["items"]; !reflect.DeepEqual(items, map[string]interface{}{"$ref": "#/definitions/SchemaAddress"}) {
+		t.Errorf("items = %v, want reference to SchemaAddress", items)
+	}
+}
+
+// TestJSONSchema_StringOrNumberTypes verifies durations and big numbers admit both the
+// strings and the numbers ParseInto accepts for them
+func TestJSONSchema_StringOrNumberTypes(t *testing.T) {
+	type Measurement struct {
+		Elapsed time.Duration `json:"elapsed"`
+		Count   *big.Int      `json:"count"`
+		Ratio   big.Float     `json:"ratio"`
+	}
+
+	props := generateSchema[Measurement](t)["properties"].(map[string]interface{})
+	stringOr := func(numberType string) map[string]interface{} {
+		return map[string]interface{}{"anyOf": []interface{}{
+			map[string]interface{}{"type": "string"},
+			map[string]interface{}{"type": numberType},
+		}}
+	}
+
+	tests := []struct {
+		property string
+		want     map[string]interface{}
+	}{
+		{"elapsed", stringOr("integer")},
+		{"count", stringOr("integer")},
+		{"ratio", stringOr("number")},
+	}
+	for _, tt := range tests {
+		if got := props[tt.property]; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("properties[%q] = %v, want %v", tt.property, got, tt.want)
+		}
+	}
+
+	// Canonical numeric payloads for these types parse
+	if _, err := model.ParseInto[Measurement]([]byte(`{"elapsed":5000000000,"count":123,"ratio":0.5}`)); err != nil {
+		t.Errorf("ParseInto() unexpected error = %v", err)
+	}
+}
+
+func TestJSONSchema_UnsupportedType(t *testing.T) {
+	type WithChannel struct {
+		Events chan string `json:"events"`
+	}
+
+	_, err := model.JSONSchema[WithChannel]()
+	if err == nil || !strings.Contains(err.Error(), "Events") {
+		t.Errorf("JSONSchema() error = %v, want unsupported field error", err)
+	}
+}
+
+type OpenAPIOrder struct {
+	ID       string            `json:"id" validate:"required" desc:"Order identifier"`
+	Shipping SchemaAddress     `json:"shipping" desc:"Delivery address"`
+	Items    []OpenAPIItem     `json:"items" validate:"min=1"`
+	Parent   *OpenAPIOrder     `json:"parent"`
+	Notes    map[string]string `json:"notes"`
+}
+
+type OpenAPIItem struct {
+	SKU      string `json:"sku" validate:"required"`
+	Quantity int    `json:"quantity" validate:"min=1" desc:"Units ordered"`
+}
+
+func TestOpenAPISchemas(t *testing.T) {
+	raw, err := model.OpenAPISchemas[OpenAPIOrder]()
+	if err != nil {
+		t.Fatalf("OpenAPISchemas() error = %v", err)
+	}
+	var schemas map[string]map[string]interface{}
+	if err := json.Unmarshal(raw, &schemas); err != nil {
+		t.Fatalf("OpenAPISchemas() produced invalid JSON: %v\n%s", err, raw)
+	}
+
+	var names []string
+	for name := range schemas {
+		names = append(names, name)
+	}
+	if len(names) != 3 || schemas["OpenAPIOrder"] == nil || schemas["OpenAPIItem"] == nil || schemas["SchemaAddress"] == nil {
+		t.Fatalf("schema names = %v, want OpenAPIOrder, OpenAPIItem, SchemaAddress", names)
+	}
+	if strings.Contains(string(raw), "$schema") || strings.Contains(string(raw), "#/definitions/") {
+		t.Errorf("OpenAPISchemas() output contains JSON Schema document keywords:\n%s", raw)
+	}
+
+	props := schemas["OpenAPIOrder"]["properties"].(map[string]interface{})
+	tests := []struct {
+		property string
+		want     map[string]interface{}
+	}{
+		{"id", map[string]interface{}{"type": "string", "description": "Order identifier"}},
+		{"shipping", map[string]interface{}{
+			"description": "Delivery address",
+			"allOf":       []interface{}{map[string]interface{}{"$ref": "#/components/schemas/SchemaAddress"}},
+		}},
+		{"items", map[string]interface{}{
+			"type":     "array",
+			"items":    map[string]interface{}{"$ref": "#/components/schemas/OpenAPIItem"},
+			"minItems": 1.0,
+		}},
+		{"parent", map[string]interface{}{"$ref": "#/components/schemas/OpenAPIOrder"}},
+	}
+	for _, tt := range tests {
+		if got := props[tt.property]; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("properties[%q] = %v, want %v", tt.property, got, tt.want)
+		}
+	}
+
+	quantity := schemas["OpenAPIItem"]["properties"].(map[string]interface{})["quantity"]
+	want := map[string]interface{}{"type": "integer", "minimum": 1.0, "description": "Units ordered"}
+	if !reflect.DeepEqual(quantity, want) {
+		t.Errorf("OpenAPIItem quantity = %v, want %v", quantity, want)
+	}
+}
+
+func TestOpenAPISchemas_MatchesJSONSchema(t *testing.T) {
+	raw, err := model.OpenAPISchemas[SchemaAddress]()
+	if err != nil {
+		t.Fatalf("OpenAPISchemas() error = %v", err)
+	}
+	var schemas map[string]interface{}
+	if err := json.Unmarshal(raw, &schemas); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	jsonSchema := generateSchema[SchemaAddress](t)
+	delete(jsonSchema, "$schema")
+	if !reflect.DeepEqual(schemas["SchemaAddress"], jsonSchema) {
+		t.Errorf("OpenAPI schema = %v, want same as JSON Schema %v", schemas["SchemaAddress"], jsonSchema)
+	}
+}
+
+func TestOpenAPISchemas_RequiresNamedStruct(t *testing.T) {
+	if _, err := model.OpenAPISchemas[[]OpenAPIItem](); err == nil {
+		t.Error("OpenAPISchemas() expected error for slice type")
+	}
+	if _, err := model.OpenAPISchemas[struct{ A int }](); err == nil {
+		t.Error("OpenAPISchemas() expected error for anonymous struct")
+	}
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// KeyedConfig names its keys once with the format-neutral key tag
+type KeyedConfig struct {
+	Port    int    `key:"port" validate:"min=1"`
+	Host    string `key:"host" yaml:"hostname"`
+	Timeout int    `key:"timeout" json:"timeout_s"`
+	Debug   bool
+}
+
+// TestKeyTag_Formats verifies the key tag applies to JSON and YAML unless a format tag overrides it
+func TestKeyTag_Formats(t *testing.T) {
+	want := KeyedConfig{Port: 8080, Host: "db", Timeout: 30, Debug: true}
+
+	cfg, err := model.ParseInto[KeyedConfig]([]byte(`{"port": 8080, "host": "db", "timeout_s": 30, "Debug": true}`))
+	if err != nil {
+		t.Fatalf("unexpected JSON error: %v", err)
+	}
+	if cfg != want {
+		t.Errorf("JSON: got %+v, want %+v", cfg, want)
+	}
+
+	cfg, err = model.ParseInto[KeyedConfig]([]byte("port: 8080\nhostname: db\ntimeout: \"30\"\nDebug: true\n"))
+	if err != nil {
+		t.Fatalf("unexpected YAML error: %v", err)
+	}
+	if cfg != want {
+		t.Errorf("YAML: got %+v, want %+v", cfg, want)
+	}
+}
+
+// TestKeyTag_ErrorsAndSchema verifies validation and schema generation use the key tag
+func TestKeyTag_ErrorsAndSchema(t *testing.T) {
+	if _, err := model.ParseInto[KeyedConfig]([]byte(`{"port": 0}`)); err == nil {
+		t.Error("expected validation error for port 0")
+	}
+
+	schema, err := model.JSONSchema[KeyedConfig]()
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+	for _, key := range []string{`"port"`, `"host"`, `"timeout_s"`} {
+		if !strings.Contains(string(schema), key) {
+			t.Errorf("schema missing %s: %s", key, schema)
+		}
+	}
+}
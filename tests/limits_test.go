@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// TestMaxArrayLength_Config verifies the array length getter/setter
+func TestMaxArrayLength_Config(t *testing.T) {
+	orig := model.GetMaxArrayLength()
+	defer model.SetMaxArrayLength(orig)
+
+	if orig != 0 {
+		t.Errorf("GetMaxArrayLength() default = %d, want 0 (unlimited)", orig)
+	}
+
+	model.SetMaxArrayLength(100)
+	if got := model.GetMaxArrayLength(); got != 100 {
+		t.Errorf("GetMaxArrayLength() = %d, want 100", got)
+	}
+	if model.MaxArrayLength != 100 {
+		t.Errorf("MaxArrayLength = %d, want 100", model.MaxArrayLength)
+	}
+}
+
+// TestMaxArrayLength_Boundary verifies arrays at the limit pass and one element more fails
+func TestMaxArrayLength_Boundary(t *testing.T) {
+	orig := model.GetMaxArrayLength()
+	defer model.SetMaxArrayLength(orig)
+	model.SetMaxArrayLength(3)
+
+	type Order struct {
+		Items []int `json:"items"`
+	}
+
+	t.Run("at limit passes", func(t *testing.T) {
+		order, err := model.ParseInto[Order]([]byte(`{"items":[1,2,3]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(order.Items) != 3 {
+			t.Errorf("Items = %v, want 3 elements", order.Items)
+		}
+	})
+
+	t.Run("one over limit fails with ParseError", func(t *testing.T) {
+		_, err := model.ParseInto[Order]([]byte(`{"items":[1,2,3,4]}`))
+
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+		}
+		if parseErr.Field != "items" {
+			t.Errorf("Field = %q, want %q", parseErr.Field, "items")
+		}
+		if !strings.Contains(parseErr.Message, "array length 4 exceeds maximum allowed length of 3") {
+			t.Errorf("unexpected message: %s", parseErr.Message)
+		}
+	})
+
+	t.Run("coerced input over limit fails", func(t *testing.T) {
+		// String elements force the map-coercion path
+		_, err := model.ParseInto[Order]([]byte(`{"items":["1","2","3","4"]}`))
+		if err == nil || !strings.Contains(err.Error(), "array length") {
+			t.Errorf("expected array length error, got %v", err)
+		}
+	})
+
+	t.Run("nested array names its path", func(t *testing.T) {
+		_, err := model.ParseInto[map[string]interface{}]([]byte(`{"a":[[1],[1,2,3,4]]}`))
+
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+		}
+		if parseErr.Field != "a[1]" {
+			t.Errorf("Field = %q, want %q", parseErr.Field, "a[1]")
+		}
+	})
+
+	t.Run("first offending key in sorted order is reported", func(t *testing.T) {
+		input := []byte(`{"zeta":[1,2,3,4],"beta":[1,2,3,4],"mu":[1,2,3,4]}`)
+		for i := 0; i < 20; i++ {
+			_, err := model.ParseInto[map[string]interface{}](input)
+
+			var parseErr *model.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+			}
+			if parseErr.Field != "beta" {
+				t.Fatalf("run %d: Field = %q, want %q", i, parseErr.Field, "beta")
+			}
+		}
+	})
+
+	t.Run("top-level YAML sequence over limit fails", func(t *testing.T) {
+		_, err := model.ParseIntoWithFormat[[]int]([]byte("- 1\n- 2\n- 3\n- 4\n"), model.FormatYAML)
+		if err == nil || !strings.Contains(err.Error(), "array length") {
+			t.Errorf("expected array length error, got %v", err)
+		}
+	})
+
+	t.Run("CoerceValue over limit fails", func(t *testing.T) {
+		value := []interface{}{1, 2, 3, 4}
+		_, err := model.CoerceValue(value, reflect.TypeOf([]int{}), "Items")
+
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+		}
+		if parseErr.Field != "Items" {
+			t.Errorf("Field = %q, want %q", parseErr.Field, "Items")
+		}
+	})
+}
+
+// TestMaxInputSize_Boundary verifies inputs at the size limit pass and larger ones fail
+func TestMaxInputSize_Boundary(t *testing.T) {
+	orig := model.GetMaxInputSize()
+	defer model.SetMaxInputSize(orig)
+
+	data := []byte(`{"items":[1,2,3]}`)
+	model.SetMaxInputSize(len(data))
+
+	type Order struct {
+		Items []int `json:"items"`
+	}
+
+	if _, err := model.ParseInto[Order](data); err != nil {
+		t.Fatalf("input at limit should pass: %v", err)
+	}
+
+	_, err := model.ParseInto[Order](append(data, ' '))
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+	}
+	if !strings.Contains(parseErr.Message, "exceeds maximum allowed size") {
+		t.Errorf("unexpected message: %s", parseErr.Message)
+	}
+}
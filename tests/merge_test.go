@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// LayeredConfig is assembled from several sources
+type LayeredConfig struct {
+	Name     string   `json:"name" yaml:"name" validate:"required"`
+	Port     int      `json:"port" yaml:"port" validate:"min=1"`
+	Hosts    []string `json:"hosts" yaml:"hosts"`
+	Database struct {
+		Host string `json:"host" yaml:"host" validate:"required"`
+		Port int    `json:"port" yaml:"port"`
+		Pool struct {
+			Max int `json:"max" yaml:"max" default:"10"`
+		} `json:"pool" yaml:"pool"`
+	} `json:"database" yaml:"database"`
+}
+
+// TestParseMerged_Precedence verifies later sources win and nested objects merge
+func TestParseMerged_Precedence(t *testing.T) {
+	defaults := []byte(`{"name": "app", "port": 8080, "hosts": ["a", "b"],
+		"database": {"host": "localhost", "port": 5432, "pool": {"max": 5}}}`)
+	file := []byte("port: 9000\nhosts:\n  - c\ndatabase:\n  host: db.internal\n")
+	env := []byte(`{"database": {"port": "6432"}}`)
+
+	cfg, err := model.ParseMerged[LayeredConfig](defaults, file, nil, env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Name != "app" || cfg.Port != 9000 {
+		t.Errorf("scalars: got %q/%d", cfg.Name, cfg.Port)
+	}
+	if len(cfg.Hosts) != 1 || cfg.Hosts[0] != "c" {
+		t.Errorf("arrays should be replaced, got %v", cfg.Hosts)
+	}
+	if cfg.Database.Host != "db.internal" || cfg.Database.Port != 6432 || cfg.Database.Pool.Max != 5 {
+		t.Errorf("nested merge: got %+v", cfg.Database)
+	}
+}
+
+// TestParseMerged_ValidatesOnce verifies only the merged result must be valid
+func TestParseMerged_ValidatesOnce(t *testing.T) {
+	// Neither layer is valid alone
+	cfg, err := model.ParseMerged[LayeredConfig]([]byte(`{"name": "app", "port": 1}`),
+		[]byte(`{"database": {"host": "h", "pool": {}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Database.Pool.Max != 10 {
+		t.Errorf("default not applied: %d", cfg.Database.Pool.Max)
+	}
+
+	_, err = model.ParseMerged[LayeredConfig]([]byte(`{"name": "app", "port": 1, "database": {}}`))
+	if err == nil || !strings.Contains(err.Error(), "Database.Host") {
+		t.Errorf("expected Database.Host required error, got %v", err)
+	}
+}
+
+// TestParseMerged_SourceErrors verifies bad sources are reported by index
+func TestParseMerged_SourceErrors(t *testing.T) {
+	_, err := model.ParseMerged[LayeredConfig]([]byte(`{"name": "app"}`), []byte(`{"port":`))
+	if err == nil || !strings.HasPrefix(err.Error(), "source 1:") {
+		t.Errorf("expected source 1 error, got %v", err)
+	}
+
+	_, err = model.ParseMerged[LayeredConfig]([]byte(`[1, 2]`))
+	if err == nil || !strings.Contains(err.Error(), "expected an object") {
+		t.Errorf("expected object error, got %v", err)
+	}
+}
+
+// MemberProfile is updated in place from partial updates
+type MemberProfile struct {
+	Name     string
+	Age      int
+	Nickname *string
+	Tags     []string
+	Address  MemberAddress
+	Manager  *MemberAddress
+	Updated  time.Time
+	Settings struct {
+		Theme  string
+		Alerts *bool
+	}
+}
+
+type MemberAddress struct {
+	City string
+	Zip  string
+}
+
+// TestMerge_Overlay verifies non-zero fields overwrite and nested structs merge recursively
+func TestMerge_Overlay(t *testing.T) {
+	nick, off := "al", false
+	updated := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	dst := MemberProfile{Name: "Alice", Age: 30, Tags: []string{"a"},
+		Address: MemberAddress{City: "Paris", Zip: "75001"}, Manager: &MemberAddress{City: "Lyon"}}
+	dst.Settings.Theme = "dark"
+
+	src := MemberProfile{Age: 31, Nickname: &nick, Address: MemberAddress{Zip: "75002"}, Updated: updated}
+	src.Settings.Alerts = &off
+
+	if err := model.Merge(&dst, &src); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if dst.Name != "Alice" || dst.Age != 31 || dst.Nickname == nil || *dst.Nickname != "al" {
+		t.Errorf("top-level fields: %+v", dst)
+	}
+	if len(dst.Tags) != 1 || dst.Manager == nil || dst.Manager.City != "Lyon" {
+		t.Errorf("zero src fields should be skipped: %+v", dst)
+	}
+	if dst.Address.City != "Paris" || dst.Address.Zip != "75002" {
+		t.Errorf("nested struct not merged: %+v", dst.Address)
+	}
+	if dst.Settings.Theme != "dark" || dst.Settings.Alerts == nil || *dst.Settings.Alerts {
+		t.Errorf("anonymous nested struct not merged: %+v", dst.Settings)
+	}
+	if !dst.Updated.Equal(updated) {
+		t.Errorf("Updated = %v, want %v", dst.Updated, updated)
+	}
+}
+
+// TestMerge_PointerReplaced verifies non-nil pointers replace rather than merge
+func TestMerge_PointerReplaced(t *testing.T) {
+	dst := MemberProfile{Manager: &MemberAddress{City: "Lyon", Zip: "69001"}}
+	src := MemberProfile{Manager: &MemberAddress{City: "Nice"}}
+
+	if err := model.Merge(&dst, &src); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dst.Manager != src.Manager {
+		t.Errorf("Manager = %+v, want src pointer", dst.Manager)
+	}
+}
+
+// TestMerge_InvalidArguments verifies nil and non-struct arguments are rejected
+func TestMerge_InvalidArguments(t *testing.T) {
+	var p MemberProfile
+	if err := model.Merge(nil, &p); err == nil {
+		t.Error("expected error for nil dst")
+	}
+
+	a, b := 1, 2
+	if err := model.Merge(&a, &b); err == nil {
+		t.Error("expected error for non-struct type")
+	}
+}
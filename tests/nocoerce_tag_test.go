@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// Voucher mixes strict fields with lenient ones
+type Voucher struct {
+	Code    string   `json:"code" yaml:"code" form:"code" coerce:"false"`
+	Uses    int      `json:"uses" yaml:"uses" form:"uses" coerce:"false" default:"1"`
+	Amount  float64  `json:"amount" yaml:"amount" form:"amount"`
+	Regions []string `json:"regions" yaml:"regions" coerce:"false"`
+}
+
+// TestCoerceTag_StrictFields verifies coerce:"false" fields reject values of another type
+func TestCoerceTag_StrictFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"number into string", `{"code":12345}`, "Code"},
+		{"string into int", `{"code":"A1","uses":"3"}`, "Uses"},
+		{"number in slice", `{"code":"A1","regions":["eu",1]}`, "Regions[1]"},
+		{"yaml number into string", "code: 12345\namount: 5\n", "Code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Voucher]([]byte(tt.input))
+
+			var parseErr *model.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+			}
+			if parseErr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", parseErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+// TestCoerceTag_OtherFieldsStayLenient verifies untagged fields still coerce and defaults apply
+func TestCoerceTag_OtherFieldsStayLenient(t *testing.T) {
+	voucher, err := model.ParseInto[Voucher]([]byte(`{"code":"A1","amount":"9.5","regions":["eu"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if voucher.Code != "A1" || voucher.Amount != 9.5 || voucher.Uses != 1 {
+		t.Errorf("unexpected result: %+v", voucher)
+	}
+}
+
+// TestCoerceTag_NestedStruct verifies the tag applies to fields of nested structs
+func TestCoerceTag_NestedStruct(t *testing.T) {
+	type Order struct {
+		Voucher Voucher `json:"voucher"`
+	}
+
+	_, err := model.ParseInto[Order]([]byte(`{"voucher":{"code":7}}`))
+
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != "Voucher.Code" {
+		t.Errorf("expected ParseError on Voucher.Code, got %v", err)
+	}
+}
+
+// TestCoerceTag_FormIgnoresTag verifies form values, which are always text, still coerce
+func TestCoerceTag_FormIgnoresTag(t *testing.T) {
+	voucher, err := model.ParseForm[Voucher](url.Values{"code": {"A1"}, "uses": {"4"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if voucher.Uses != 4 {
+		t.Errorf("Uses = %d, want 4", voucher.Uses)
+	}
+}
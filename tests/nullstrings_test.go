@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type NullableRecord struct {
+	Name     string   `json:"name" form:"name" csv:"name"`
+	Nickname *string  `json:"nickname" form:"nickname" csv:"nickname"`
+	Score    *int     `json:"score" form:"score" csv:"score"`
+	Level    int      `json:"level" form:"level" csv:"level" default:"1"`
+	Tags     []string `json:"tags" form:"tag" csv:"tags"`
+}
+
+// TestNullStrings_DisabledByDefault verifies sentinel strings are coerced normally by default
+func TestNullStrings_DisabledByDefault(t *testing.T) {
+	if got := model.GetNullStrings(); len(got) != 0 {
+		t.Fatalf("GetNullStrings() = %v, want none by default", got)
+	}
+
+	record, err := model.ParseInto[NullableRecord]([]byte(`{"name":"null","nickname":"nil"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Name != "null" || record.Nickname == nil || *record.Nickname != "nil" {
+		t.Errorf("unexpected result: %+v", record)
+	}
+
+	if _, err := model.ParseInto[NullableRecord]([]byte(`{"score":"null"}`)); err == nil {
+		t.Error("expected coercion error for \"null\" into *int")
+	}
+}
+
+// TestNullStrings_JSON verifies sentinels become nil pointers and zero values
+func TestNullStrings_JSON(t *testing.T) {
+	model.SetNullStrings([]string{"null", "NULL", "nil"})
+	defer model.SetNullStrings(nil)
+
+	record, err := model.ParseInto[NullableRecord]([]byte(`{"name":"NULL","nickname":"null","score":"nil","level":"null","tags":["a","null"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Name != "" || record.Nickname != nil || record.Score != nil || record.Level != 0 {
+		t.Errorf("unexpected result: %+v", record)
+	}
+	if len(record.Tags) != 2 || record.Tags[1] != "" {
+		t.Errorf("Tags = %q, want [a \"\"]", record.Tags)
+	}
+
+	// Matching is exact
+	record, err = model.ParseInto[NullableRecord]([]byte(`{"name":"Null"}`))
+	if err != nil || record.Name != "Null" {
+		t.Errorf("Name = %q, %v; want Null", record.Name, err)
+	}
+}
+
+// TestNullStrings_FormAndCSV verifies sentinels are treated as absent values
+func TestNullStrings_FormAndCSV(t *testing.T) {
+	model.SetNullStrings([]string{"null"})
+	defer model.SetNullStrings(nil)
+
+	form, err := model.ParseForm[NullableRecord](url.Values{"name": {"null"}, "score": {"null"}, "level": {"null"}})
+	if err != nil {
+		t.Fatalf("ParseForm unexpected error: %v", err)
+	}
+	if form.Name != "" || form.Score != nil || form.Level != 1 {
+		t.Errorf("ParseForm = %+v, want empty name, nil score, default level", form)
+	}
+
+	rows, err := model.ParseCSV[NullableRecord]([]byte("name,nickname,score,level\nada,null,null,null\n"))
+	if err != nil {
+		t.Fatalf("ParseCSV unexpected error: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Nickname != nil || rows[0].Score != nil || rows[0].Level != 1 {
+		t.Errorf("ParseCSV = %+v, want nil nickname and score, default level", rows)
+	}
+}
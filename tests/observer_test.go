@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// ObservedUser is parsed while an Observer is installed
+type ObservedUser struct {
+	Name string `json:"name" validate:"required"`
+	Age  int    `json:"age" validate:"min=18"`
+}
+
+// captureMetrics installs an Observer recording metrics for ObservedUser parses only
+func captureMetrics(t *testing.T) func() []model.ParseMetrics {
+	var mu sync.Mutex
+	var captured []model.ParseMetrics
+
+	model.SetObserver(model.ObserverFunc(func(m model.ParseMetrics) {
+		if m.Type != "tests.ObservedUser" {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		captured = append(captured, m)
+	}))
+	t.Cleanup(func() { model.SetObserver(nil) })
+
+	return func() []model.ParseMetrics {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]model.ParseMetrics(nil), captured...)
+	}
+}
+
+// TestObserver_FastPath verifies input matching the struct reports no coercion time
+func TestObserver_FastPath(t *testing.T) {
+	metrics := captureMetrics(t)
+
+	input := []byte(`{"name":"ada","age":30}`)
+	if _, err := model.ParseInto[ObservedUser](input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := metrics()
+	if len(got) != 1 {
+		t.Fatalf("observed %d parses, want 1", len(got))
+	}
+	m := got[0]
+	if !m.FastPath || m.Coerce != 0 || m.Err != nil {
+		t.Errorf("metrics = %+v, want fast path without coercion", m)
+	}
+	if m.Format != model.FormatJSON || m.Size != len(input) {
+		t.Errorf("Format = %v, Size = %d; want JSON, %d", m.Format, m.Size, len(input))
+	}
+	if m.Total <= 0 || m.Decode+m.Validate > m.Total {
+		t.Errorf("inconsistent timings: %+v", m)
+	}
+}
+
+// TestObserver_CoercionPath verifies coerced input reports the slow path and the error
+func TestObserver_CoercionPath(t *testing.T) {
+	metrics := captureMetrics(t)
+
+	_, err := model.ParseInto[ObservedUser]([]byte(`{"name":"ada","age":"12"}`))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+
+	got := metrics()
+	if len(got) != 1 {
+		t.Fatalf("observed %d parses, want 1", len(got))
+	}
+	m := got[0]
+	if m.FastPath || m.Total <= 0 {
+		t.Errorf("metrics = %+v, want the coercion path", m)
+	}
+	if m.Err == nil || m.Err.Error() != err.Error() {
+		t.Errorf("Err = %v, want %v", m.Err, err)
+	}
+	if m.Decode+m.Coerce+m.Validate > m.Total {
+		t.Errorf("phases exceed total: %+v", m)
+	}
+}
+
+// TestObserver_Removed verifies SetObserver(nil) stops reporting
+func TestObserver_Removed(t *testing.T) {
+	metrics := captureMetrics(t)
+	model.SetObserver(nil)
+
+	if _, err := model.ParseInto[ObservedUser]([]byte(`{"name":"ada","age":30}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := metrics(); len(got) != 0 {
+		t.Errorf("observed %d parses after removal, want 0", len(got))
+	}
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// WidthRecord has one field per sized integer type
+type WidthRecord struct {
+	I8  int8   `json:"i8"`
+	I16 int16  `json:"i16"`
+	I32 int32  `json:"i32"`
+	I64 int64  `json:"i64"`
+	U8  uint8  `json:"u8"`
+	U16 uint16 `json:"u16"`
+	U32 uint32 `json:"u32"`
+	U64 uint64 `json:"u64"`
+}
+
+// TestIntegerWidth_Boundaries verifies values at each width's limits parse and one past fails
+func TestIntegerWidth_Boundaries(t *testing.T) {
+	tests := []struct {
+		field string
+		value string
+		valid bool
+	}{
+		{"i8", "127", true},
+		{"i8", "-128", true},
+		{"i8", "128", false},
+		{"i8", "-129", false},
+		{"i16", "32767", true},
+		{"i16", "-32768", true},
+		{"i16", "32768", false},
+		{"i16", "-32769", false},
+		{"i32", "2147483647", true},
+		{"i32", "-2147483648", true},
+		{"i32", "2147483648", false},
+		{"i32", "-2147483649", false},
+		{"i64", "9223372036854775807", true},
+		{"i64", "-9223372036854775808", true},
+		{"i64", "9223372036854775808", false},
+		{"i64", "-9223372036854775809", false},
+		{"u8", "255", true},
+		{"u8", "256", false},
+		{"u16", "65535", true},
+		{"u16", "65536", false},
+		{"u32", "4294967295", true},
+		{"u32", "4294967296", false},
+		{"u64", "18446744073709551615", true},
+		{"u64", "18446744073709551616", false},
+	}
+
+	for _, tt := range tests {
+		for _, quoted := range []bool{false, true} {
+			value := tt.value
+			if quoted {
+				// String values take the coercion path rather than encoding/json
+				value = `"` + value + `"`
+			}
+
+			t.Run(tt.field+"="+value, func(t *testing.T) {
+				_, err := model.ParseInto[WidthRecord]([]byte(`{"` + tt.field + `":` + value + `}`))
+				if tt.valid {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					return
+				}
+
+				var parseErr *model.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+				}
+				if want := strings.ToUpper(tt.field); parseErr.Field != want {
+					t.Errorf("Field = %q, want %q", parseErr.Field, want)
+				}
+				if !strings.Contains(parseErr.Message, "out of range") {
+					t.Errorf("unexpected message: %s", parseErr.Message)
+				}
+			})
+		}
+	}
+}
+
+// TestIntegerWidth_BoundaryValues verifies the limits themselves are stored exactly
+func TestIntegerWidth_BoundaryValues(t *testing.T) {
+	record, err := model.ParseInto[WidthRecord]([]byte(`{
+		"i8": "-128", "i16": 32767, "i32": "-2147483648", "i64": -9223372036854775808,
+		"u8": "255", "u16": 65535, "u32": "4294967295", "u64": 18446744073709551615
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := WidthRecord{
+		I8: -128, I16: 32767, I32: -2147483648, I64: -9223372036854775808,
+		U8: 255, U16: 65535, U32: 4294967295, U64: 18446744073709551615,
+	}
+	if record != want {
+		t.Errorf("record = %+v, want %+v", record, want)
+	}
+}
+
+// TestIntegerWidth_Containers verifies slice elements and map values are range checked
+func TestIntegerWidth_Containers(t *testing.T) {
+	type Levels struct {
+		List  []int8           `json:"list"`
+		Named map[string]uint8 `json:"named"`
+	}
+
+	_, err := model.ParseInto[Levels]([]byte(`{"list":[1,"300"]}`))
+	if err == nil || !strings.Contains(err.Error(), "out of range for int8") {
+		t.Errorf("expected int8 range error, got %v", err)
+	}
+
+	_, err = model.ParseInto[Levels]([]byte(`{"named":{"a":"256"}}`))
+	if err == nil || !strings.Contains(err.Error(), "out of range for uint8") {
+		t.Errorf("expected uint8 range error, got %v", err)
+	}
+}
+
+// TestIntegerWidth_CoerceValue verifies floats and named types are checked against the target width
+func TestIntegerWidth_CoerceValue(t *testing.T) {
+	type Level int8
+
+	tests := []struct {
+		input  interface{}
+		target reflect.Type
+		valid  bool
+	}{
+		{float64(127), reflect.TypeOf(Level(0)), true},
+		{float64(300), reflect.TypeOf(Level(0)), false},
+		{float64(1e19), reflect.TypeOf(int64(0)), false},
+		{float64(1e20), reflect.TypeOf(uint64(0)), false},
+		{int64(-1), reflect.TypeOf(uint16(0)), false},
+		{"70000", reflect.TypeOf(uint16(0)), false},
+	}
+
+	for _, tt := range tests {
+		_, err := model.CoerceValue(tt.input, tt.target, "Value")
+		if tt.valid && err != nil {
+			t.Errorf("CoerceValue(%v, %s) unexpected error: %v", tt.input, tt.target, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("CoerceValue(%v, %s) expected error", tt.input, tt.target)
+		}
+	}
+}
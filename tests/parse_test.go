@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -159,6 +161,83 @@ func TestParseInto_TimeFields(t *testing.T) {
 	}
 }
 
+func TestParseInto_DurationFields(t *testing.T) {
+	type ServerConfig struct {
+		ReadTimeout  time.Duration   `json:"read_timeout" yaml:"read_timeout"`
+		WriteTimeout *time.Duration  `json:"write_timeout" yaml:"write_timeout"`
+		Backoff      []time.Duration `json:"backoff" yaml:"backoff"`
+	}
+
+	writeTimeout := 2 * time.Minute
+
+	tests := []struct {
+		name    string
+		input   []byte
+		want    ServerConfig
+		wantErr bool
+	}{
+		{
+			name:  "duration strings",
+			input: []byte(`{"read_timeout":"30s", "write_timeout":"2m", "backoff":["100ms","1.5s"]}`),
+			want: ServerConfig{
+				ReadTimeout:  30 * time.Second,
+				WriteTimeout: &writeTimeout,
+				Backoff:      []time.Duration{100 * time.Millisecond, 1500 * time.Millisecond},
+			},
+		},
+		{
+			name:  "numeric nanoseconds",
+			input: []byte(`{"read_timeout":1000000000, "backoff":["250", 5]}`),
+			want: ServerConfig{
+				ReadTimeout: time.Second,
+				Backoff:     []time.Duration{250, 5},
+			},
+		},
+		{
+			name:  "YAML durations",
+			input: []byte("read_timeout: 1h30m\nwrite_timeout: 2m\nbackoff: [1s]\n"),
+			want: ServerConfig{
+				ReadTimeout:  90 * time.Minute,
+				WriteTimeout: &writeTimeout,
+				Backoff:      []time.Duration{time.Second},
+			},
+		},
+		{
+			name:    "invalid duration",
+			input:   []byte(`{"read_timeout":"soon"}`),
+			wantErr: true,
+		},
+		{
+			name:    "fractional nanoseconds",
+			input:   []byte(`{"read_timeout":1.5}`),
+			wantErr: true,
+		},
+		{
+			name:    "2^63 nanoseconds overflows",
+			input:   []byte(`{"read_timeout":9223372036854775808}`),
+			wantErr: true,
+		},
+		{
+			name:  "-2^63 nanoseconds fits",
+			input: []byte(`{"read_timeout":-9223372036854775808}`),
+			want:  ServerConfig{ReadTimeout: time.Duration(-1 << 63)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.ParseInto[ServerConfig](tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInto() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseInto() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func mustParseTime(t *testing.T, format, value string) time.Time {
 	parsed, err := time.Parse(format, value)
 	if err != nil {
@@ -256,3 +335,104 @@ func TestParseInto_Arrays(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInto_FloatPrecision(t *testing.T) {
+	type Item struct {
+		ID     string  `json:"id"`
+		Price  float64 `json:"price"`
+		Weight float32 `json:"weight"`
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		wantPrice    float64
+		strictErrors bool
+	}{
+		// String prices are coerced by gopantic; shortest formatting round-trips to the input
+		{"two decimal price", `{"id":1,"price":"19.99"}`, 19.99, false},
+		{"trailing zeros", `{"id":1,"price":"19.990"}`, 19.99, false},
+		{"exponent form", `{"id":1,"price":"1.999e1"}`, 19.99, false},
+		{"float32 field", `{"id":1,"price":"1","weight":"0.1"}`, 1, false},
+		{"too many digits", `{"id":1,"price":"0.10000000000000000001"}`, 0.1, true},
+		{"integer beyond 2^53", `{"id":1,"price":"9007199254740993"}`, 9007199254740992, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Default: lenient, rounds to the nearest float64
+			item, err := model.ParseInto[Item]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() unexpected error = %v", err)
+			}
+			if item.Price != tt.wantPrice {
+				t.Errorf("Price = %v, want %v", item.Price, tt.wantPrice)
+			}
+
+			model.SetStrictFloatPrecision(true)
+			defer model.SetStrictFloatPrecision(false)
+
+			_, err = model.ParseInto[Item]([]byte(tt.input))
+			if (err != nil) != tt.strictErrors {
+				t.Errorf("strict ParseInto() error = %v, wantErr %v", err, tt.strictErrors)
+			}
+		})
+	}
+}
+
+// TestParseInto_FlatStructErrors verifies flat structs report coercion errors before validation errors
+func TestParseInto_FlatStructErrors(t *testing.T) {
+	_, err := model.ParseInto[FlatConfig]([]byte(`{"name": "x", "host": "h", "port": "many", "workers": 0}`))
+
+	errs, ok := err.(model.ErrorList)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("expected 4 errors, got %v", err)
+	}
+	if _, ok := errs[0].(*model.ParseError); !ok {
+		t.Errorf("errs[0] = %v, want the Port parse error first", errs[0])
+	}
+	for _, e := range errs[1:] {
+		if _, ok := e.(*model.ValidationError); !ok {
+			t.Errorf("expected validation error, got %T: %v", e, e)
+		}
+	}
+}
+
+// TestParseInto_MatchingTypesCoercionPath verifies correctly typed values are stored exactly on the coercion path
+func TestParseInto_MatchingTypesCoercionPath(t *testing.T) {
+	record, err := model.ParseInto[TypedRecord]([]byte(`{"id": 9007199254740993, "count": -4096,
+		"size": 70000, "price": 19.99, "score": 1e-3, "name": "widget", "enabled": true}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := TypedRecord{ID: 9007199254740993, Count: -4096, Size: 70000, Price: 19.99,
+		Score: 0.001, Name: "widget", Enabled: true, Region: "eu"}
+	if record != want {
+		t.Errorf("record = %+v, want %+v", record, want)
+	}
+}
+
+func TestMustParseInto(t *testing.T) {
+	user := model.MustParseInto[User]([]byte(`{"id": "7", "name": "Alice"}`))
+	if user.ID != 7 || user.Name != "Alice" {
+		t.Errorf("MustParseInto() = %+v", user)
+	}
+
+	defer func() {
+		recovered := recover()
+		err, ok := recovered.(error)
+		if !ok {
+			t.Fatalf("expected an error panic, got %v", recovered)
+		}
+		var parseErr *model.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Field != "ID" {
+			t.Errorf("expected the panic to wrap a ParseError on ID, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "MustParseInto[tests.User]") {
+			t.Errorf("panic message should name the target type, got %q", err.Error())
+		}
+	}()
+	model.MustParseInto[User]([]byte(`{"id": "seven"}`))
+	t.Fatal("expected MustParseInto to panic")
+}
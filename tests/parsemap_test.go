@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// MapConfig is parsed from already decoded data
+type MapConfig struct {
+	Name    string            `json:"name" validate:"required"`
+	Port    int               `json:"port" validate:"min=1,max=65535"`
+	Debug   bool              `json:"debug"`
+	Retries int               `json:"retries" default:"3"`
+	Labels  map[string]string `json:"labels"`
+	DB      struct {
+		Host string `json:"host" validate:"required"`
+	} `json:"db"`
+}
+
+// TestParseMap_Coerces verifies Go values of any coercible type are accepted
+func TestParseMap_Coerces(t *testing.T) {
+	cfg, err := model.ParseMap[MapConfig](map[string]interface{}{
+		"name":   "api",
+		"port":   "8080", // e.g. an environment override
+		"debug":  1,
+		"labels": map[string]interface{}{"team": "core"},
+		"db":     map[string]interface{}{"host": "localhost"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Port != 8080 || !cfg.Debug || cfg.Retries != 3 || cfg.Labels["team"] != "core" || cfg.DB.Host != "localhost" {
+		t.Errorf("unexpected result: %+v", cfg)
+	}
+
+	// Maps from encoding/json with UseNumber decode as with ParseInto
+	decoder := json.NewDecoder(strings.NewReader(`{"name":"api","port":443,"db":{"host":"h"}}`))
+	decoder.UseNumber()
+	var m map[string]interface{}
+	if err := decoder.Decode(&m); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, err := model.ParseMap[MapConfig](m); err != nil || cfg.Port != 443 {
+		t.Errorf("json.Number input: got %+v, %v", cfg, err)
+	}
+}
+
+// TestParseMap_Errors verifies validation, coercion, and structure limits apply
+func TestParseMap_Errors(t *testing.T) {
+	_, err := model.ParseMap[MapConfig](map[string]interface{}{"port": 70000, "db": map[string]interface{}{}})
+	for _, field := range []string{`"Name"`, `"Port"`, `"DB.Host"`} {
+		if err == nil || !strings.Contains(err.Error(), field) {
+			t.Errorf("expected error on %s, got %v", field, err)
+		}
+	}
+
+	if _, err := model.ParseMap[MapConfig](map[string]interface{}{"name": "a", "port": "http"}); err == nil {
+		t.Error("expected coercion error for port")
+	}
+
+	orig := model.GetMaxStructureDepth()
+	defer model.SetMaxStructureDepth(orig)
+	model.SetMaxStructureDepth(1)
+	if _, err := model.ParseMap[MapConfig](map[string]interface{}{"db": map[string]interface{}{"host": "h"}}); err == nil {
+		t.Error("expected depth limit error")
+	}
+}
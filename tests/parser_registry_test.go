@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// FormatProperties is a custom format of key=value lines
+const FormatProperties model.Format = 100
+
+// propertiesParser parses key=value lines into a flat object. Input starting with a
+// "#!properties" line is detected as this format.
+type propertiesParser struct{}
+
+func (propertiesParser) Parse(raw []byte) (interface{}, error) {
+	data := map[string]interface{}{}
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("properties parse error: line %d has no '='", line)
+		}
+		data[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return data, nil
+}
+
+func (propertiesParser) Format() model.Format { return FormatProperties }
+
+func (propertiesParser) Detect(raw []byte) bool {
+	return bytes.HasPrefix(raw, []byte("#!properties"))
+}
+
+// ServerProperties is parsed from the properties format
+type ServerProperties struct {
+	Host    string `json:"host" validate:"required"`
+	Port    int    `json:"port" validate:"min=1,max=65535"`
+	Verbose bool   `json:"verbose"`
+}
+
+func registerProperties(t *testing.T) {
+	t.Helper()
+	if err := model.RegisterParser(FormatProperties, propertiesParser{}); err != nil {
+		t.Fatalf("RegisterParser() error = %v", err)
+	}
+	t.Cleanup(func() { _ = model.RegisterParser(FormatProperties, nil) })
+}
+
+func TestRegisterParser_ParseIntoWithFormat(t *testing.T) {
+	registerProperties(t)
+
+	server, err := model.ParseIntoWithFormat[ServerProperties]([]byte("host = localhost\nport = 8080\nverbose = true\n"), FormatProperties)
+	if err != nil {
+		t.Fatalf("ParseIntoWithFormat() error = %v", err)
+	}
+	if server.Host != "localhost" || server.Port != 8080 || !server.Verbose {
+		t.Errorf("got %+v", server)
+	}
+
+	if got := model.GetParser(FormatProperties).Format(); got != FormatProperties {
+		t.Errorf("GetParser().Format() = %v, want %v", got, FormatProperties)
+	}
+}
+
+func TestRegisterParser_Detection(t *testing.T) {
+	raw := []byte("#!properties\nhost=db.internal\nport=0\n")
+	if got := model.DetectFormat(raw); got != model.FormatYAML {
+		t.Fatalf("DetectFormat() before registration = %v, want YAML", got)
+	}
+
+	registerProperties(t)
+	if got := model.DetectFormat(raw); got != FormatProperties {
+		t.Fatalf("DetectFormat() = %v, want %v", got, FormatProperties)
+	}
+
+	// Detected input is coerced and validated like any other format
+	_, err := model.ParseInto[ServerProperties](raw)
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "Port" {
+		t.Fatalf("expected a port validation error, got %v", err)
+	}
+
+	// Other input keeps the built-in detection
+	if got := model.DetectFormat([]byte(`{"host": "a"}`)); got != model.FormatJSON {
+		t.Errorf("DetectFormat(JSON) = %v, want JSON", got)
+	}
+}
+
+func TestRegisterParser_Errors(t *testing.T) {
+	registerProperties(t)
+
+	_, err := model.ParseIntoWithFormat[ServerProperties]([]byte("host localhost"), FormatProperties)
+	if err == nil || !strings.Contains(err.Error(), "line 1 has no '='") {
+		t.Errorf("expected the parser's error, got %v", err)
+	}
+
+	for _, format := range []model.Format{model.FormatJSON, model.FormatYAML, model.FormatJSONC} {
+		if err := model.RegisterParser(format, propertiesParser{}); err == nil {
+			t.Errorf("RegisterParser(%v) should reject a built-in format", format)
+		}
+	}
+}
+
+func TestRegisterParser_StructureLimits(t *testing.T) {
+	registerProperties(t)
+
+	original := model.GetMaxArrayLength()
+	model.SetMaxArrayLength(1)
+	defer model.SetMaxArrayLength(original)
+
+	if err := model.RegisterParser(FormatProperties+1, listParser{}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = model.RegisterParser(FormatProperties+1, nil) }()
+
+	if _, err := model.GetParser(FormatProperties + 1).Parse([]byte("a,b")); err == nil ||
+		!strings.Contains(err.Error(), "array length 2 exceeds") {
+		t.Errorf("expected an array length error, got %v", err)
+	}
+}
+
+// listParser parses comma-separated values into an array
+type listParser struct{}
+
+func (listParser) Parse(raw []byte) (interface{}, error) {
+	var items []interface{}
+	for _, item := range strings.Split(string(raw), ",") {
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (listParser) Format() model.Format { return FormatProperties + 1 }
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -189,3 +190,156 @@ func float64Ptr(v float64) *float64 {
 func boolPtr(v bool) *bool {
 	return &v
 }
+
+// UserPatch models a PATCH payload where absent and null must be distinguished
+type UserPatch struct {
+	Name    *string `json:"name" yaml:"name"`
+	Email   *string `json:"email" yaml:"email"`
+	Address *struct {
+		City *string `json:"city" yaml:"city"`
+	} `json:"address" yaml:"address"`
+}
+
+func TestParseIntoWithPresence(t *testing.T) {
+	patch, presence, err := model.ParseIntoWithPresence[UserPatch]([]byte(`{"name":"Alice","email":null,"address":{"city":null}}`))
+	if err != nil {
+		t.Fatalf("ParseIntoWithPresence() unexpected error = %v", err)
+	}
+
+	if patch.Name == nil || *patch.Name != "Alice" {
+		t.Errorf("Name = %v, want Alice", patch.Name)
+	}
+	if patch.Email != nil {
+		t.Errorf("Email = %v, want nil", patch.Email)
+	}
+
+	tests := []struct {
+		path        string
+		wantPresent bool
+		wantNull    bool
+	}{
+		{"name", true, false},
+		{"email", true, true},
+		{"address", true, false},
+		{"address.city", true, true},
+		{"missing", false, false},
+		{"address.zip", false, false},
+	}
+	for _, tt := range tests {
+		if got := presence.Has(tt.path); got != tt.wantPresent {
+			t.Errorf("Has(%q) = %v, want %v", tt.path, got, tt.wantPresent)
+		}
+		if got := presence.IsNull(tt.path); got != tt.wantNull {
+			t.Errorf("IsNull(%q) = %v, want %v", tt.path, got, tt.wantNull)
+		}
+	}
+
+	want := []string{"address", "address.city", "email", "name"}
+	if got := presence.Keys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+}
+
+func TestParseIntoWithPresence_YAML(t *testing.T) {
+	_, presence, err := model.ParseIntoWithPresence[UserPatch]([]byte("name: Bob\nemail: ~\n"))
+	if err != nil {
+		t.Fatalf("ParseIntoWithPresence() unexpected error = %v", err)
+	}
+	if !presence.IsNull("email") || presence.Has("address") {
+		t.Errorf("unexpected presence keys: %v", presence.Keys())
+	}
+}
+
+func TestParseIntoWithPresence_Error(t *testing.T) {
+	_, presence, err := model.ParseIntoWithPresence[PersonWithPointers]([]byte(`{"age":5}`))
+	if err == nil {
+		t.Fatal("expected validation error for missing name")
+	}
+	if presence != nil || presence.Has("age") {
+		t.Error("presence should be nil on error")
+	}
+}
+
+// PatchTag is an element of a pointer-to-slice field
+type PatchTag struct {
+	Name string `json:"name" yaml:"name" validate:"required"`
+}
+
+// CollectionPatch has optional collection fields for PATCH-style requests
+type CollectionPatch struct {
+	IDs   *[]int      `json:"ids" yaml:"ids"`
+	Tags  *[]PatchTag `json:"tags" yaml:"tags"`
+	Names *[]string   `json:"names" yaml:"names"`
+}
+
+func TestParseInto_PointerToSlice(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantIDs   *[]int
+		wantTags  *[]PatchTag
+		wantNames *[]string
+	}{
+		{
+			name:     "coerced elements",
+			input:    `{"ids": ["1", 2], "tags": [{"name": "a"}]}`,
+			wantIDs:  &[]int{1, 2},
+			wantTags: &[]PatchTag{{Name: "a"}},
+		},
+		{
+			name:      "matching types",
+			input:     `{"ids": [3], "names": ["x", "y"]}`,
+			wantIDs:   &[]int{3},
+			wantNames: &[]string{"x", "y"},
+		},
+		{
+			name:    "null and absent stay nil",
+			input:   `{"ids": null, "tags": null}`,
+			wantIDs: nil,
+		},
+		{
+			name:      "empty array is a pointer to an empty slice",
+			input:     `{"names": []}`,
+			wantNames: &[]string{},
+		},
+		{
+			name:     "YAML",
+			input:    "ids: ['4']\ntags:\n  - name: b\n",
+			wantIDs:  &[]int{4},
+			wantTags: &[]PatchTag{{Name: "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.ParseInto[CollectionPatch]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.IDs, tt.wantIDs) {
+				t.Errorf("IDs = %v, want %v", got.IDs, tt.wantIDs)
+			}
+			if !reflect.DeepEqual(got.Tags, tt.wantTags) {
+				t.Errorf("Tags = %v, want %v", got.Tags, tt.wantTags)
+			}
+			if !reflect.DeepEqual(got.Names, tt.wantNames) {
+				t.Errorf("Names = %v, want %v", got.Names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestParseInto_PointerToSliceErrors(t *testing.T) {
+	_, err := model.ParseInto[CollectionPatch]([]byte(`{"ids": ["1", "two"]}`))
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != "IDs[1]" {
+		t.Errorf("expected a ParseError on IDs[1], got %v", err)
+	}
+
+	// Elements behind the pointer are validated
+	_, err = model.ParseInto[CollectionPatch]([]byte(`{"tags": [{"name": "a"}, {"name": ""}]}`))
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.FieldPath != "Tags[1].Name" {
+		t.Errorf("expected a ValidationError on Tags[1].Name, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// TreeNode is a self-referential type reached through slices, pointers, and maps
+type TreeNode struct {
+	Name     string               `json:"name" validate:"required"`
+	Weight   int                  `json:"weight" default:"1" validate:"min=1"`
+	Children []TreeNode           `json:"children"`
+	Parent   *TreeNode            `json:"parent"`
+	Index    map[string]*TreeNode `json:"index"`
+}
+
+// TestRecursive_ParseTree verifies a tree parses, coerces, and applies defaults at every level
+func TestRecursive_ParseTree(t *testing.T) {
+	data := []byte(`{
+		"name": "root",
+		"children": [
+			{"name": "a", "weight": "2", "children": [{"name": "a1"}]},
+			{"name": "b"}
+		],
+		"index": {"leaf": {"name": "leaf", "weight": 3}}
+	}`)
+
+	root, err := model.ParseInto[TreeNode](data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if root.Weight != 1 {
+		t.Errorf("root.Weight = %d, want default 1", root.Weight)
+	}
+	if len(root.Children) != 2 || root.Children[0].Weight != 2 {
+		t.Fatalf("unexpected children: %+v", root.Children)
+	}
+	if got := root.Children[0].Children[0]; got.Name != "a1" || got.Weight != 1 {
+		t.Errorf("grandchild = %+v, want a1 with default weight", got)
+	}
+	if leaf := root.Index["leaf"]; leaf == nil || leaf.Weight != 3 {
+		t.Errorf("Index[leaf] = %+v, want weight 3", leaf)
+	}
+}
+
+// TestRecursive_ValidateTree verifies nested failures are reported with their full path
+func TestRecursive_ValidateTree(t *testing.T) {
+	data := []byte(`{"name":"root","children":[{"name":"a","children":[{"name":""}]}]}`)
+
+	_, err := model.ParseInto[TreeNode](data)
+
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *model.ValidationError, got %T: %v", err, err)
+	}
+	if validationErr.FieldPath != "Children[0].Children[0].Name" {
+		t.Errorf("FieldPath = %q, want %q", validationErr.FieldPath, "Children[0].Children[0].Name")
+	}
+}
+
+// TestRecursive_CyclicValueTerminates verifies a pointer cycle stops at MaxValidationDepth
+func TestRecursive_CyclicValueTerminates(t *testing.T) {
+	node := &TreeNode{Name: "loop", Weight: 1}
+	node.Parent = node
+
+	err := model.Validate(node)
+	if err == nil || !strings.Contains(err.Error(), "validation depth exceeded") {
+		t.Errorf("expected validation depth error, got %v", err)
+	}
+}
+
+// TestRecursive_Metadata verifies Explain and JSONSchema terminate on recursive types
+func TestRecursive_Metadata(t *testing.T) {
+	rules := model.Explain[TreeNode]()
+	if len(rules["Name"]) != 1 || len(rules["Weight"]) != 1 {
+		t.Errorf("unexpected rules: %v", rules)
+	}
+
+	raw, err := model.JSONSchema[TreeNode]()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Ref   string `json:"$ref"`
+			Items struct {
+				Ref string `json:"$ref"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("invalid schema JSON: %v", err)
+	}
+	if got := schema.Properties["parent"].Ref; got != "#" {
+		t.Errorf("parent $ref = %q, want %q", got, "#")
+	}
+	if got := schema.Properties["children"].Items.Ref; got != "#" {
+		t.Errorf("children items $ref = %q, want %q", got, "#")
+	}
+}
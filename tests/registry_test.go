@@ -3,6 +3,7 @@ package tests
 import (
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/vnykmshr/gopantic/pkg/model"
@@ -532,3 +533,116 @@ func TestListValidatorsStability(t *testing.T) {
 		}
 	}
 }
+
+// LateValidated references a validator that is registered only after the type is first validated
+type LateValidated struct {
+	Code string `json:"code" validate:"late_registered_rule"`
+}
+
+// TestClearValidationCache_LateRegistration tests that validators registered after a type
+// has been cached apply once the cache is cleared
+func TestClearValidationCache_LateRegistration(t *testing.T) {
+	value := LateValidated{Code: "x"}
+
+	// Unknown validator names are ignored, so the type is cached as having no rules
+	if err := model.Validate(&value); err != nil {
+		t.Fatalf("Validate() unexpected error before registration = %v", err)
+	}
+
+	model.RegisterGlobalFunc("late_registered_rule", func(fieldName string, value interface{}, params map[string]interface{}) error {
+		return model.NewValidationError(fieldName, value, "late_registered_rule", "always fails")
+	})
+	model.ClearValidationCache()
+
+	if err := model.Validate(&value); err == nil {
+		t.Error("Validate() should apply validator registered after ClearValidationCache")
+	}
+}
+
+type OverrideContact struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// TestValidatorRegistry_Override tests that Override replaces built-ins regardless of precedence
+func TestValidatorRegistry_Override(t *testing.T) {
+	t.Run("replaces cross-field validator", func(t *testing.T) {
+		registry := model.NewValidatorRegistry()
+		registry.Override("gtfield", func(fieldName string, value interface{}, params map[string]interface{}) error {
+			return nil
+		})
+
+		v := registry.Create("gtfield", map[string]interface{}{"value": "Floor"})
+		if _, ok := v.(*model.CustomFuncValidator); !ok {
+			t.Errorf("Create() = %T, want *model.CustomFuncValidator after Override", v)
+		}
+	})
+
+	t.Run("changes ParseInto behavior for email", func(t *testing.T) {
+		registry := model.GetDefaultRegistry()
+		defer func() {
+			registry.Disable("email")
+			registry.Register("email", func(params map[string]interface{}) model.Validator {
+				return &model.EmailValidator{}
+			})
+			model.ClearValidationCache()
+		}()
+
+		input := []byte(`{"email":"alice@gmail.com"}`)
+		if _, err := model.ParseInto[OverrideContact](input); err != nil {
+			t.Fatalf("ParseInto() unexpected error with built-in email = %v", err)
+		}
+
+		registry.Override("email", func(fieldName string, value interface{}, params map[string]interface{}) error {
+			if s, _ := value.(string); !strings.HasSuffix(s, "@example.com") {
+				return model.NewValidationError(fieldName, value, "email", "must be a company address")
+			}
+			return nil
+		})
+		model.ClearValidationCache()
+
+		if _, err := model.ParseInto[OverrideContact](input); err == nil || !strings.Contains(err.Error(), "company address") {
+			t.Errorf("ParseInto() error = %v, want overridden email error", err)
+		}
+		if _, err := model.ParseInto[OverrideContact]([]byte(`{"email":"bob@example.com"}`)); err != nil {
+			t.Errorf("ParseInto() unexpected error = %v", err)
+		}
+	})
+}
+
+// TestValidatorRegistry_Disable tests that disabled validators are ignored
+func TestValidatorRegistry_Disable(t *testing.T) {
+	registry := model.NewValidatorRegistry()
+	registry.RegisterFunc("email", func(fieldName string, value interface{}, params map[string]interface{}) error {
+		return nil
+	})
+	registry.Disable("email")
+	registry.Disable("gtfield")
+
+	for _, name := range []string{"email", "gtfield"} {
+		if v := registry.Create(name, nil); v != nil {
+			t.Errorf("Create(%q) = %T, want nil after Disable", name, v)
+		}
+		for _, listed := range registry.ListValidators() {
+			if listed == name {
+				t.Errorf("ListValidators() still contains %q", name)
+			}
+		}
+	}
+
+	t.Run("ParseInto ignores disabled email", func(t *testing.T) {
+		defaultRegistry := model.GetDefaultRegistry()
+		defer func() {
+			defaultRegistry.Register("email", func(params map[string]interface{}) model.Validator {
+				return &model.EmailValidator{}
+			})
+			model.ClearValidationCache()
+		}()
+
+		defaultRegistry.Disable("email")
+		model.ClearValidationCache()
+
+		if _, err := model.ParseInto[OverrideContact]([]byte(`{"email":"not-an-email"}`)); err != nil {
+			t.Errorf("ParseInto() unexpected error with email disabled = %v", err)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// reportTestErrors returns an ErrorList with one error and one warning
+func reportTestErrors() model.ErrorList {
+	var errs model.ErrorList
+	errs.Add(model.NewValidationErrorWithDetails("Age", "Age", 15, "min", "value must be at least 18",
+		map[string]interface{}{"min": 18, "actual": 15}))
+
+	warning := model.NewValidationError("Nickname", "x", "min", "nickname is short")
+	warning.Severity = model.SeverityWarning
+	errs.Add(warning)
+	return errs
+}
+
+// TestErrorReport_ToXML verifies the XML envelope layout
+func TestErrorReport_ToXML(t *testing.T) {
+	data, err := reportTestErrors().ToXML()
+	if err != nil {
+		t.Fatalf("ToXML() unexpected error: %v", err)
+	}
+
+	var report struct {
+		XMLName xml.Name `xml:"validation_errors"`
+		Count   int      `xml:"count,attr"`
+		Errors  []struct {
+			Field  string `xml:"field,attr"`
+			Value  string `xml:"value"`
+			Errors []struct {
+				Rule    string `xml:"rule,attr"`
+				Message string `xml:"message"`
+				Details []struct {
+					Name  string `xml:"name,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"detail"`
+			} `xml:"validation_error"`
+		} `xml:"error"`
+		Warnings []struct {
+			Field string `xml:"field,attr"`
+		} `xml:"warning"`
+	}
+	if err := xml.Unmarshal(data, &report); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, data)
+	}
+
+	if report.Count != 1 || len(report.Errors) != 1 {
+		t.Fatalf("unexpected report: %s", data)
+	}
+	fieldErr := report.Errors[0]
+	if fieldErr.Field != "Age" || fieldErr.Value != "15" {
+		t.Errorf("field = %q, value = %q; want Age, 15", fieldErr.Field, fieldErr.Value)
+	}
+	if len(fieldErr.Errors) != 1 || fieldErr.Errors[0].Rule != "min" || fieldErr.Errors[0].Message != "value must be at least 18" {
+		t.Errorf("unexpected validation errors: %+v", fieldErr.Errors)
+	}
+	details := fieldErr.Errors[0].Details
+	if len(details) != 2 || details[0].Name != "actual" || details[1].Name != "min" || details[1].Value != "18" {
+		t.Errorf("details = %+v, want actual and min sorted by name", details)
+	}
+	if len(report.Warnings) != 1 || report.Warnings[0].Field != "Nickname" {
+		t.Errorf("warnings = %+v, want Nickname", report.Warnings)
+	}
+}
+
+// TestErrorReport_XMLRedactsSensitiveValues verifies XML output uses sanitized values
+func TestErrorReport_XMLRedactsSensitiveValues(t *testing.T) {
+	var errs model.ErrorList
+	errs.Add(model.NewValidationError("Password", "hunter2", "min", "too short"))
+
+	data, err := errs.ToXML()
+	if err != nil {
+		t.Fatalf("ToXML() unexpected error: %v", err)
+	}
+	if strings.Contains(string(data), "hunter2") || !strings.Contains(string(data), model.RedactedValue) {
+		t.Errorf("expected redacted value, got %s", data)
+	}
+}
+
+// TestErrorReport_Marshal verifies built-in and registered formats
+func TestErrorReport_Marshal(t *testing.T) {
+	errs := reportTestErrors()
+
+	jsonData, err := errs.Marshal("json")
+	if err != nil {
+		t.Fatalf("Marshal(json) unexpected error: %v", err)
+	}
+	var report model.StructuredErrorReport
+	if err := json.Unmarshal(jsonData, &report); err != nil || report.Count != 1 {
+		t.Errorf("Marshal(json) = %s, %v", jsonData, err)
+	}
+
+	xmlData, err := errs.Marshal("xml")
+	if err != nil || !strings.HasPrefix(string(xmlData), "<validation_errors") {
+		t.Errorf("Marshal(xml) = %s, %v", xmlData, err)
+	}
+
+	model.RegisterErrorEncoder("count", func(report *model.StructuredErrorReport) ([]byte, error) {
+		return []byte(strings.Repeat("!", report.Count)), nil
+	})
+	custom, err := errs.Marshal("count")
+	if err != nil || string(custom) != "!" {
+		t.Errorf("Marshal(count) = %q, %v; want \"!\"", custom, err)
+	}
+
+	if _, err := errs.Marshal("csv"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+// TestErrorReport_MarshalEncoderError verifies encoder errors are returned as-is
+func TestErrorReport_MarshalEncoderError(t *testing.T) {
+	errEncode := errors.New("encoder failed")
+	model.RegisterErrorEncoder("failing", func(*model.StructuredErrorReport) ([]byte, error) {
+		return nil, errEncode
+	})
+
+	if _, err := reportTestErrors().Marshal("failing"); !errors.Is(err, errEncode) {
+		t.Errorf("Marshal(failing) error = %v, want %v", err, errEncode)
+	}
+}
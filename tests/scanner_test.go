@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// OrderStatus is an enum that, like many database types, only implements sql.Scanner
+type OrderStatus int
+
+const (
+	StatusPending OrderStatus = iota + 1
+	StatusShipped
+)
+
+// Scan accepts the status name or its numeric code
+func (s *OrderStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		switch v {
+		case "pending":
+			*s = StatusPending
+		case "shipped":
+			*s = StatusShipped
+		default:
+			return fmt.Errorf("unknown order status %q", v)
+		}
+	case int64:
+		if v < int64(StatusPending) || v > int64(StatusShipped) {
+			return fmt.Errorf("unknown order status code %d", v)
+		}
+		*s = OrderStatus(v)
+	default:
+		return fmt.Errorf("unsupported order status type %T", src)
+	}
+	return nil
+}
+
+// ScannedOrder mixes a custom Scanner enum with database/sql null types
+type ScannedOrder struct {
+	Status   OrderStatus     `json:"status" yaml:"status"`
+	History  []OrderStatus   `json:"history" yaml:"history"`
+	Note     sql.NullString  `json:"note" yaml:"note"`
+	Quantity sql.NullInt64   `json:"quantity" yaml:"quantity"`
+	Discount sql.NullFloat64 `json:"discount" yaml:"discount"`
+}
+
+// TestScanner_Coercion verifies sql.Scanner types are decoded through Scan
+func TestScanner_Coercion(t *testing.T) {
+	order, err := model.ParseInto[ScannedOrder]([]byte(`{"status": "shipped", "history": ["pending", 2],
+		"note": "fragile", "quantity": "3", "discount": 0.5}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order.Status != StatusShipped || len(order.History) != 2 || order.History[1] != StatusShipped {
+		t.Errorf("enum values: %+v", order)
+	}
+	if !order.Note.Valid || order.Note.String != "fragile" {
+		t.Errorf("Note = %+v", order.Note)
+	}
+	if !order.Quantity.Valid || order.Quantity.Int64 != 3 || order.Discount.Float64 != 0.5 {
+		t.Errorf("Quantity = %+v, Discount = %+v", order.Quantity, order.Discount)
+	}
+
+	yamlOrder, err := model.ParseInto[ScannedOrder]([]byte("status: 1\nnote: null\n"))
+	if err != nil {
+		t.Fatalf("unexpected YAML error: %v", err)
+	}
+	if yamlOrder.Status != StatusPending || yamlOrder.Note.Valid {
+		t.Errorf("YAML: %+v", yamlOrder)
+	}
+}
+
+// TestScanner_Errors verifies Scan errors become ParseErrors on the field
+func TestScanner_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		field string
+	}{
+		{`{"status": "lost"}`, "Status"},
+		{`{"status": 9}`, "Status"},
+		{`{"history": ["pending", "lost"]}`, "History[1]"},
+		{`{"status": {"name": "pending"}}`, "Status"},
+	}
+
+	for _, tt := range tests {
+		_, err := model.ParseInto[ScannedOrder]([]byte(tt.input))
+		if err == nil || !strings.Contains(err.Error(), `"`+tt.field+`"`) {
+			t.Errorf("%s: expected error on %s, got %v", tt.input, tt.field, err)
+		}
+	}
+}
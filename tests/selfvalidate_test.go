@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type StayBooking struct {
+	Start  time.Time `json:"start" validate:"required"`
+	End    time.Time `json:"end" validate:"required"`
+	Guests int       `json:"guests" validate:"min=1"`
+}
+
+func (b *StayBooking) ValidateStruct() error {
+	if !b.End.After(b.Start) {
+		return model.NewValidationError("End", b.End, "after_start", "end must be after start")
+	}
+	return nil
+}
+
+type Discount struct {
+	Percent int `json:"percent"`
+	Amount  int `json:"amount"`
+}
+
+// ValidateStruct uses a value receiver and reports several problems at once
+func (d Discount) ValidateStruct() error {
+	var errs model.ErrorList
+	if d.Percent > 0 && d.Amount > 0 {
+		errs.Add(errors.New("only one of percent or amount may be set"))
+	}
+	if d.Percent > 100 {
+		errs.Add(errors.New("percent must not exceed 100"))
+	}
+	return errs.AsError()
+}
+
+type Reservation struct {
+	Booking  StayBooking `json:"booking"`
+	Discount *Discount   `json:"discount"`
+}
+
+func TestSelfValidator_ParseInto(t *testing.T) {
+	valid := `{"start":"2024-05-01T12:00:00Z","end":"2024-05-03T10:00:00Z","guests":"2"}`
+	if _, err := model.ParseInto[StayBooking]([]byte(valid)); err != nil {
+		t.Fatalf("ParseInto() unexpected error = %v", err)
+	}
+
+	invalid := `{"start":"2024-05-03T12:00:00Z","end":"2024-05-01T10:00:00Z","guests":2}`
+	_, err := model.ParseInto[StayBooking]([]byte(invalid))
+	var errs model.ErrorList
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("ParseInto() error = %v, want single validation error", err)
+	}
+	if ve, ok := errs[0].(*model.ValidationError); !ok || ve.Rule != "after_start" {
+		t.Errorf("ParseInto() error = %v, want after_start validation error", errs[0])
+	}
+}
+
+func TestSelfValidator_SkippedWhenFieldsInvalid(t *testing.T) {
+	// Guests fails min=1; the end-before-start invariant is not reported
+	input := `{"start":"2024-05-03T12:00:00Z","end":"2024-05-01T10:00:00Z","guests":0}`
+	_, err := model.ParseInto[StayBooking]([]byte(input))
+	if err == nil {
+		t.Fatal("ParseInto() expected error, got nil")
+	}
+	if strings.Contains(err.Error(), "end must be after start") {
+		t.Errorf("ParseInto() error = %v, self-validation should not run on invalid fields", err)
+	}
+}
+
+func TestSelfValidator_NestedAndMerged(t *testing.T) {
+	input := `{
+		"booking": {"start":"2024-05-01T12:00:00Z","end":"2024-05-03T10:00:00Z","guests":1},
+		"discount": {"percent":"150","amount":5}
+	}`
+	_, err := model.ParseInto[Reservation]([]byte(input))
+	var errs model.ErrorList
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("ParseInto() error = %v, want 2 merged discount errors", err)
+	}
+
+	nested := `{"booking": {"start":"2024-05-03T12:00:00Z","end":"2024-05-01T10:00:00Z","guests":1}}`
+	if _, err := model.ParseInto[Reservation]([]byte(nested)); err == nil || !strings.Contains(err.Error(), "end must be after start") {
+		t.Errorf("ParseInto() error = %v, want nested self-validation error", err)
+	}
+}
+
+func TestSelfValidator_Validate(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	r := Reservation{
+		Booking:  StayBooking{Start: start, End: start.Add(48 * time.Hour), Guests: 2},
+		Discount: &Discount{Percent: 10},
+	}
+	if err := model.Validate(&r); err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	r.Discount.Amount = 5
+	if err := model.Validate(&r); err == nil || !strings.Contains(err.Error(), "only one of percent or amount") {
+		t.Errorf("Validate() error = %v, want discount invariant error", err)
+	}
+
+	// Discount has no field rules; Validate still runs its hook
+	if err := model.Validate(&Discount{Percent: 101}); err == nil {
+		t.Error("Validate() expected error for Discount without field rules")
+	}
+}
+
+func TestSelfValidator_NestedFieldPath(t *testing.T) {
+	inputs := map[string]string{
+		"coerced": `{"booking": {"start":"2024-05-03T12:00:00Z","end":"2024-05-01T10:00:00Z","guests":"1"}}`,
+		"strict":  `{"booking": {"start":"2024-05-03T12:00:00Z","end":"2024-05-01T10:00:00Z","guests":1}}`,
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			_, err := model.ParseInto[Reservation]([]byte(input))
+			var validationErr *model.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ParseInto() error = %v, want a ValidationError", err)
+			}
+			if validationErr.FieldPath != "Booking.End" {
+				t.Errorf("FieldPath = %q, want %q as reported by Validate", validationErr.FieldPath, "Booking.End")
+			}
+		})
+	}
+
+	// Validate reports the same path
+	start := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	err := model.Validate(&Reservation{Booking: StayBooking{Start: start, End: start.Add(-time.Hour), Guests: 1}})
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.FieldPath != "Booking.End" {
+		t.Errorf("Validate() error = %v, want FieldPath Booking.End", err)
+	}
+}
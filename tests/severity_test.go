@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type LintedConfig struct {
+	Name     string         `json:"name" validate:"required"`
+	Workers  int            `json:"workers" validate:"min=1,max=64,warn"`
+	LogLevel string         `json:"log_level" validate:"contains=info,warn,unknown_rule,warn"`
+	Server   LintedEndpoint `json:"server"`
+}
+
+type LintedEndpoint struct {
+	Host string `json:"host" validate:"required,min=4,warn"`
+}
+
+func TestSeverity_WarningsDoNotFailParse(t *testing.T) {
+	inputs := map[string]string{
+		"standard decode": `{"name":"svc","workers":128,"log_level":"debug","server":{"host":"db"}}`,
+		"coercion path":   `{"name":"svc","workers":"128","log_level":"debug","server":{"host":"db"}}`,
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := model.ParseInto[LintedConfig]([]byte(input))
+			if err != nil {
+				t.Fatalf("ParseInto() unexpected error = %v", err)
+			}
+			if cfg.Workers != 128 || cfg.Server.Host != "db" {
+				t.Errorf("ParseInto() = %+v, want parsed values despite warnings", cfg)
+			}
+		})
+	}
+}
+
+func TestSeverity_FailureCarriesWarnings(t *testing.T) {
+	_, err := model.ParseInto[LintedConfig]([]byte(`{"workers":0,"log_level":"debug","server":{"host":"db"}}`))
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("ParseInto() error = %v, want ErrorList", err)
+	}
+
+	fatal := errs.Errors()
+	if len(fatal) != 2 {
+		t.Errorf("Errors() = %v, want required Name and min Workers", fatal)
+	}
+	warnings := errs.Warnings()
+	if len(warnings) != 2 || !strings.Contains(warnings[0].Error(), "validation warning on field") {
+		t.Errorf("Warnings() = %v, want LogLevel and nested Host warnings", warnings)
+	}
+}
+
+func TestSeverity_ValidateDetailed(t *testing.T) {
+	cfg := LintedConfig{Name: "svc", Workers: 100, LogLevel: "debug", Server: LintedEndpoint{Host: "db"}}
+
+	if err := model.Validate(&cfg); err != nil {
+		t.Errorf("Validate() unexpected error = %v", err)
+	}
+
+	errs := model.ValidateDetailed(&cfg)
+	if errs == nil {
+		t.Fatal("ValidateDetailed() = nil, want warnings")
+	}
+	if len(errs.Errors()) != 0 {
+		t.Errorf("Errors() = %v, want none", errs.Errors())
+	}
+
+	warnings := errs.Warnings()
+	rules := map[string]bool{}
+	for _, w := range warnings {
+		rules[w.(*model.ValidationError).Rule] = true
+	}
+	if len(warnings) != 3 || !rules["max"] || !rules["contains"] || !rules["min"] {
+		t.Errorf("Warnings() = %v, want max, contains and nested min", warnings)
+	}
+
+	report := errs.ToStructuredReport()
+	if report.Count != 0 || len(report.Errors) != 0 || len(report.Warnings) != 3 {
+		t.Errorf("ToStructuredReport() = %+v, want 0 errors and 3 warnings", report)
+	}
+	data, err := json.Marshal(report)
+	if err != nil || !strings.Contains(string(data), `"warnings":[`) || !strings.Contains(string(data), `"errors":[]`) {
+		t.Errorf("report JSON = %s, want separate errors and warnings", data)
+	}
+}
+
+func TestSeverity_ValidatorReturnedWarning(t *testing.T) {
+	model.RegisterGlobalFunc("deprecated_value", func(fieldName string, value interface{}, params map[string]interface{}) error {
+		if value == "legacy" {
+			warning := model.NewValidationError(fieldName, value, "deprecated_value", "legacy mode is deprecated")
+			warning.Severity = model.SeverityWarning
+			return warning
+		}
+		return nil
+	})
+	model.RegisterGlobalFunc("plain_error_rule", func(fieldName string, value interface{}, params map[string]interface{}) error {
+		return errors.New("plain failure")
+	})
+
+	type Mode struct {
+		Mode  string `json:"mode" validate:"deprecated_value"`
+		Extra string `json:"extra" validate:"plain_error_rule,warn"`
+	}
+
+	mode, err := model.ParseInto[Mode]([]byte(`{"mode":"legacy"}`))
+	if err != nil || mode.Mode != "legacy" {
+		t.Errorf("ParseInto() = %+v, %v, want success with warning only", mode, err)
+	}
+
+	errs := model.ValidateDetailed(&mode)
+	if errs == nil || len(errs.Warnings()) != 2 || len(errs.Errors()) != 0 {
+		t.Errorf("ValidateDetailed() = %v, want 2 warnings (plain error downgraded by warn)", errs)
+	}
+}
+
+func TestSeverity_String(t *testing.T) {
+	if model.SeverityError.String() != "error" || model.SeverityWarning.String() != "warning" {
+		t.Errorf("Severity.String() = %q, %q", model.SeverityError, model.SeverityWarning)
+	}
+}
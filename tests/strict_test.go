@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// LineItem holds integer amounts that must not be silently truncated
+type LineItem struct {
+	Quantity int    `json:"quantity" yaml:"quantity"`
+	Cents    uint32 `json:"cents" yaml:"cents"`
+	Splits   []int  `json:"splits" yaml:"splits"`
+}
+
+// TestParseIntoStrict_RejectsFractions verifies fractional numbers fail instead of truncating
+func TestParseIntoStrict_RejectsFractions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"json number", `{"quantity": 19.99}`, "Quantity"},
+		{"json string", `{"quantity": "19.99"}`, "Quantity"},
+		{"unsigned", `{"cents": 1999.5}`, "Cents"},
+		{"slice element", `{"splits": [1, 2.5]}`, "Splits[1]"},
+		{"yaml", "quantity: 19.99\ncents: 5\n", "Quantity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseIntoStrict[LineItem]([]byte(tt.input))
+
+			var parseErr *model.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+			}
+			if !strings.HasSuffix(parseErr.Field, tt.field) {
+				t.Errorf("Field = %q, want suffix %q", parseErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+// TestParseIntoStrict_AcceptsWholeNumbers verifies whole numbers in float form still parse
+func TestParseIntoStrict_AcceptsWholeNumbers(t *testing.T) {
+	item, err := model.ParseIntoStrict[LineItem]([]byte(`{"quantity": 19.0, "cents": 1e3, "splits": ["4", 5]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Quantity != 19 || item.Cents != 1000 || len(item.Splits) != 2 || item.Splits[0] != 4 {
+		t.Errorf("unexpected result: %+v", item)
+	}
+}
+
+// TestParseInto_TruncatesByDefault verifies the default mode keeps lenient truncation
+func TestParseInto_TruncatesByDefault(t *testing.T) {
+	item, err := model.ParseInto[LineItem]([]byte(`{"quantity": 19.99}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Quantity != 19 {
+		t.Errorf("Quantity = %d, want 19", item.Quantity)
+	}
+
+	item, err = model.ParseIntoWithOptions[LineItem]([]byte(`{"quantity": 19.99}`), model.CoerceOptions{})
+	if err != nil || item.Quantity != 19 {
+		t.Errorf("zero CoerceOptions: got %d, %v; want 19, nil", item.Quantity, err)
+	}
+}
+
+// ExactAccount is parsed with coercion disabled
+type ExactAccount struct {
+	Name     string         `json:"name" yaml:"name" validate:"required"`
+	Active   bool           `json:"active" yaml:"active"`
+	Balance  float64        `json:"balance" yaml:"balance"`
+	Logins   int            `json:"logins" yaml:"logins"`
+	Tags     []string       `json:"tags" yaml:"tags"`
+	Limits   map[int]uint16 `json:"limits" yaml:"limits"`
+	Nickname *string        `json:"nickname" yaml:"nickname"`
+	Meta     interface{}    `json:"meta" yaml:"meta"`
+}
+
+// TestParseIntoNoCoerce_ExactTypes verifies matching input parses as with ParseInto
+func TestParseIntoNoCoerce_ExactTypes(t *testing.T) {
+	input := `{"name":"ada","active":true,"balance":1.5,"logins":3,"tags":["a"],
+		"limits":{"1":10},"nickname":"a","meta":"anything"}`
+
+	account, err := model.ParseIntoNoCoerce[ExactAccount]([]byte(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if account.Name != "ada" || !account.Active || account.Logins != 3 || account.Limits[1] != 10 {
+		t.Errorf("unexpected result: %+v", account)
+	}
+
+	yamlInput := "name: ada\nactive: true\nlogins: 3\nlimits:\n  \"1\": 10\n"
+	if _, err := model.ParseIntoNoCoerce[ExactAccount]([]byte(yamlInput)); err != nil {
+		t.Errorf("unexpected YAML error: %v", err)
+	}
+}
+
+// TestParseIntoNoCoerce_RejectsMismatches verifies values of another type fail instead of coercing
+func TestParseIntoNoCoerce_RejectsMismatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"string into bool", `{"name":"ada","active":"true"}`, "Active"},
+		{"number into string", `{"name":42}`, "Name"},
+		{"string into int", `{"name":"ada","logins":"3"}`, "Logins"},
+		{"fraction into int", `{"name":"ada","logins":3.5}`, "Logins"},
+		{"number in string slice", `{"name":"ada","tags":["a",1]}`, "Tags[1]"},
+		{"string into map value", `{"name":"ada","limits":{"1":"10"}}`, "Limits[1]"},
+		{"number into pointer", `{"name":"ada","nickname":7}`, "Nickname"},
+		{"yaml quoted bool", "name: ada\nactive: \"true\"\n", "Active"},
+		{"yaml fraction", "name: ada\nlogins: 3.5\n", "Logins"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseIntoNoCoerce[ExactAccount]([]byte(tt.input))
+
+			var parseErr *model.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+			}
+			if parseErr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", parseErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+// TestParseIntoNoCoerce_Validates verifies validation still runs with coercion disabled
+func TestParseIntoNoCoerce_Validates(t *testing.T) {
+	_, err := model.ParseIntoNoCoerce[ExactAccount]([]byte(`{"active":true}`))
+
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "Name" {
+		t.Errorf("expected required error on Name, got %v", err)
+	}
+}
+
+// WideForm has many independently validated fields
+type WideForm struct {
+	Name     string `json:"name" validate:"required,min=2"`
+	Email    string `json:"email" validate:"required,email"`
+	Age      int    `json:"age" validate:"min=18,max=120"`
+	Zip      string `json:"zip" validate:"len=5,numeric"`
+	Phone    string `json:"phone" validate:"required"`
+	Country  string `json:"country" validate:"len=2,alpha"`
+	City     string `json:"city" validate:"required"`
+	Street   string `json:"street" validate:"required"`
+	Company  string `json:"company" validate:"min=2"`
+	Website  string `json:"website" validate:"startswith=https://"`
+	Quantity int    `json:"quantity" validate:"min=1"`
+	Budget   int    `json:"budget" validate:"min=100"`
+}
+
+// wideFormInput fails coercion on age and validation on every other field
+var wideFormInput = []byte(`{"name": "a", "email": "nope", "age": "old", "zip": "12", "country": "USA",
+	"company": "x", "website": "http://x", "quantity": "0", "budget": "5"}`)
+
+// TestParseIntoFailFast_FirstError verifies only the first error is returned
+func TestParseIntoFailFast_FirstError(t *testing.T) {
+	_, err := model.ParseInto[WideForm](wideFormInput)
+	var all model.ErrorList
+	if !errors.As(err, &all) || len(all) < 10 {
+		t.Fatalf("ParseInto should collect every error, got %v", err)
+	}
+
+	_, err = model.ParseIntoFailFast[WideForm](wideFormInput)
+	var list model.ErrorList
+	if !errors.As(err, &list) || len(list) != 1 {
+		t.Fatalf("expected a single error, got %v", err)
+	}
+
+	// Nested structs and slices stop early too
+	type Batch struct {
+		Forms []WideForm `json:"forms"`
+	}
+	_, err = model.ParseIntoFailFast[Batch]([]byte(`{"forms": [{"name": "ok"}, {"email": "x"}]}`))
+	if !errors.As(err, &list) || len(list) != 1 {
+		t.Errorf("expected a single nested error, got %v", err)
+	}
+
+	if _, err := model.ParseIntoFailFast[LineItem]([]byte(`{"quantity": 2}`)); err != nil {
+		t.Errorf("valid input: unexpected error %v", err)
+	}
+}
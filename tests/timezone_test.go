@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// ZonedEvent normalizes its timestamps with tz tags
+type ZonedEvent struct {
+	Raw       time.Time   `json:"raw"`
+	CreatedAt time.Time   `json:"created_at" tz:"UTC"`
+	StartsAt  *time.Time  `json:"starts_at" tz:"America/New_York"`
+	Reminders []time.Time `json:"reminders" tz:"UTC"`
+	Nested    ZonedWindow `json:"window"`
+}
+
+// ZonedWindow is nested inside ZonedEvent
+type ZonedWindow struct {
+	Opens time.Time `json:"opens" tz:"Asia/Tokyo"`
+}
+
+func TestParseInto_TimeZoneTag(t *testing.T) {
+	input := []byte(`{
+		"raw": "2024-03-01T09:00:00+05:30",
+		"created_at": "2024-03-01T09:00:00+05:30",
+		"starts_at": "2024-03-01T12:00:00Z",
+		"reminders": ["2024-03-01T08:00:00+01:00", 1709280000],
+		"window": {"opens": "2024-03-01T00:00:00Z"}
+	}`)
+
+	event, err := model.ParseInto[ZonedEvent](input)
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	// Without the tag the input offset is kept
+	if _, offset := event.Raw.Zone(); offset != 5*3600+1800 {
+		t.Errorf("Raw offset = %d, want +05:30", offset)
+	}
+
+	// With it the instant is unchanged and only the zone differs
+	if event.CreatedAt.Location() != time.UTC || !event.CreatedAt.Equal(event.Raw) {
+		t.Errorf("CreatedAt = %v, want %v in UTC", event.CreatedAt, event.Raw)
+	}
+	if event.CreatedAt.Hour() != 3 || event.CreatedAt.Minute() != 30 {
+		t.Errorf("CreatedAt = %v, want 03:30 UTC", event.CreatedAt)
+	}
+
+	if event.StartsAt == nil || event.StartsAt.Location().String() != "America/New_York" || event.StartsAt.Hour() != 7 {
+		t.Errorf("StartsAt = %v, want 07:00 America/New_York", event.StartsAt)
+	}
+
+	for i, reminder := range event.Reminders {
+		if reminder.Location() != time.UTC {
+			t.Errorf("Reminders[%d] = %v, want UTC", i, reminder)
+		}
+	}
+
+	if event.Nested.Opens.Location().String() != "Asia/Tokyo" || event.Nested.Opens.Hour() != 9 {
+		t.Errorf("window.opens = %v, want 09:00 Asia/Tokyo", event.Nested.Opens)
+	}
+}
+
+func TestParseInto_TimeZoneTagErrors(t *testing.T) {
+	type unknownZone struct {
+		At time.Time `json:"at" tz:"Mars/Olympus_Mons"`
+	}
+	_, err := model.ParseInto[unknownZone]([]byte(`{"at": "2024-03-01T00:00:00Z"}`))
+	if err == nil || !strings.Contains(err.Error(), `unknown time zone "Mars/Olympus_Mons"`) {
+		t.Errorf("expected an unknown time zone error, got %v", err)
+	}
+
+	type notTime struct {
+		At string `json:"at" tz:"UTC"`
+	}
+	_, err = model.ParseInto[notTime]([]byte(`{"at": "2024-03-01T00:00:00Z"}`))
+	if err == nil || !strings.Contains(err.Error(), "tz applies only to time.Time fields") {
+		t.Errorf("expected a field type error, got %v", err)
+	}
+}
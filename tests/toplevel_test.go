@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type TopLevelItem struct {
+	ID   int    `json:"id" yaml:"id" validate:"min=1"`
+	Name string `json:"name" yaml:"name" validate:"required"`
+}
+
+// TestTopLevel_Slice verifies slices of structs are coerced and every element validated
+func TestTopLevel_Slice(t *testing.T) {
+	items, err := model.ParseInto[[]TopLevelItem]([]byte(`[{"id":"1","name":"a"},{"id":2,"name":"b"}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != 1 || items[1].Name != "b" {
+		t.Errorf("unexpected result: %+v", items)
+	}
+
+	// Correctly typed input takes the standard-unmarshal path and must still be validated
+	_, err = model.ParseInto[[]TopLevelItem]([]byte(`[{"id":1,"name":"a"},{"id":2,"name":""}]`))
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *model.ValidationError, got %T: %v", err, err)
+	}
+	if validationErr.FieldPath != "[1].Name" {
+		t.Errorf("FieldPath = %q, want %q", validationErr.FieldPath, "[1].Name")
+	}
+}
+
+// TestTopLevel_Array verifies fixed-size arrays are coerced
+func TestTopLevel_Array(t *testing.T) {
+	arr, err := model.ParseInto[[2]int]([]byte(`["1","2"]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if arr != [2]int{1, 2} {
+		t.Errorf("got %v, want [1 2]", arr)
+	}
+}
+
+// TestTopLevel_Map verifies maps are coerced and struct values validated by key
+func TestTopLevel_Map(t *testing.T) {
+	counts, err := model.ParseInto[map[string]int]([]byte(`{"a":"1","b":2}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if counts["a"] != 1 || counts["b"] != 2 {
+		t.Errorf("unexpected result: %v", counts)
+	}
+
+	items, err := model.ParseInto[map[string]TopLevelItem]([]byte(`{"x":{"id":"1","name":"a"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items["x"].ID != 1 {
+		t.Errorf("unexpected result: %+v", items)
+	}
+
+	for _, input := range []string{
+		`{"x":{"id":1,"name":""}}`,   // standard-unmarshal path
+		`{"x":{"id":"1","name":""}}`, // coercion path
+	} {
+		_, err = model.ParseInto[map[string]TopLevelItem]([]byte(input))
+		var validationErr *model.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("%s: expected *model.ValidationError, got %T: %v", input, err, err)
+		}
+		if validationErr.FieldPath != "[x].Name" {
+			t.Errorf("%s: FieldPath = %q, want %q", input, validationErr.FieldPath, "[x].Name")
+		}
+	}
+}
+
+// TestTopLevel_YAMLMap verifies YAML mappings parse into top-level maps
+func TestTopLevel_YAMLMap(t *testing.T) {
+	items, err := model.ParseIntoWithFormat[map[string]TopLevelItem]([]byte("x:\n  id: 1\n  name: a\n"), model.FormatYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items["x"].Name != "a" {
+		t.Errorf("unexpected result: %+v", items)
+	}
+}
+
+// TestTopLevel_Pointer verifies pointers to structs are allocated, coerced, and validated
+func TestTopLevel_Pointer(t *testing.T) {
+	item, err := model.ParseInto[*TopLevelItem]([]byte(`{"id":"3","name":"c"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item == nil || item.ID != 3 {
+		t.Errorf("unexpected result: %+v", item)
+	}
+
+	if _, err := model.ParseInto[*TopLevelItem]([]byte(`{"id":0,"name":"c"}`)); err == nil {
+		t.Error("expected min validation error")
+	}
+}
+
+// TestTopLevel_Scalars verifies primitive targets are coerced
+func TestTopLevel_Scalars(t *testing.T) {
+	n, err := model.ParseInto[int]([]byte(`"42"`))
+	if err != nil || n != 42 {
+		t.Errorf("ParseInto[int] = %d, %v; want 42", n, err)
+	}
+
+	s, err := model.ParseInto[string]([]byte(`"hello"`))
+	if err != nil || s != "hello" {
+		t.Errorf("ParseInto[string] = %q, %v; want hello", s, err)
+	}
+
+	b, err := model.ParseInto[bool]([]byte(`"true"`))
+	if err != nil || !b {
+		t.Errorf("ParseInto[bool] = %v, %v; want true", b, err)
+	}
+
+	if _, err := model.ParseInto[int]([]byte(`"abc"`)); err == nil {
+		t.Error("expected coercion error for non-numeric string")
+	}
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type TransformContact struct {
+	Email   string   `json:"email" transform:"trim,lower" validate:"required,email"`
+	Name    string   `json:"name" transform:"trim, lower, title"`
+	Country *string  `json:"country" transform:"upper" validate:"len=2"`
+	Tags    []string `json:"tags" transform:"trim,lower"`
+}
+
+func TestTransform_AppliedBeforeValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"json", `{"email":"  User@Example.COM ","name":" aDA  lovelace ","country":"gb","tags":[" Go ","RUST"]}`},
+		{"yaml", "email: '  User@Example.COM '\nname: ' aDA  lovelace '\ncountry: gb\ntags: [' Go ', RUST]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.ParseInto[TransformContact]([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ParseInto() error = %v", err)
+			}
+
+			if got.Email != "user@example.com" {
+				t.Errorf("Email = %q, want %q", got.Email, "user@example.com")
+			}
+			if got.Name != "Ada  Lovelace" {
+				t.Errorf("Name = %q, want %q", got.Name, "Ada  Lovelace")
+			}
+			if got.Country == nil || *got.Country != "GB" {
+				t.Errorf("Country = %v, want GB", got.Country)
+			}
+			if strings.Join(got.Tags, ",") != "go,rust" {
+				t.Errorf("Tags = %q, want [go rust]", got.Tags)
+			}
+		})
+	}
+}
+
+func TestTransform_ValidationSeesTransformedValue(t *testing.T) {
+	_, err := model.ParseInto[TransformContact]([]byte(`{"email":"   "}`))
+	if err == nil || !strings.Contains(err.Error(), "required") {
+		t.Errorf("ParseInto() error = %v, want required error for whitespace-only email", err)
+	}
+}
+
+func TestTransform_NestedAndForm(t *testing.T) {
+	type Signup struct {
+		Contact TransformContact `json:"contact"`
+	}
+
+	got, err := model.ParseInto[Signup]([]byte(`{"contact":{"email":" A@B.io "}}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+	if got.Contact.Email != "a@b.io" {
+		t.Errorf("nested Email = %q, want %q", got.Contact.Email, "a@b.io")
+	}
+
+	form, err := model.ParseForm[TransformContact](url.Values{"email": {" A@B.io "}, "tags": {" X "}})
+	if err != nil {
+		t.Fatalf("ParseForm() error = %v", err)
+	}
+	if form.Email != "a@b.io" || len(form.Tags) != 1 || form.Tags[0] != "x" {
+		t.Errorf("ParseForm() = %+v, want transformed values", form)
+	}
+}
+
+func TestTransform_Custom(t *testing.T) {
+	model.RegisterTransform("digits", func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, s)
+	})
+
+	type Phone struct {
+		Number string `json:"number" transform:"digits" validate:"len=10"`
+	}
+
+	got, err := model.ParseInto[Phone]([]byte(`{"number":"(555) 123-4567"}`))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+	if got.Number != "5551234567" {
+		t.Errorf("Number = %q, want %q", got.Number, "5551234567")
+	}
+}
+
+func TestTransform_Errors(t *testing.T) {
+	type UnknownTransform struct {
+		Name string `json:"name" transform:"reverse"`
+	}
+	if _, err := model.ParseInto[UnknownTransform]([]byte(`{"name":"x"}`)); err == nil || !strings.Contains(err.Error(), `unknown transform "reverse"`) {
+		t.Errorf("ParseInto() error = %v, want unknown transform error", err)
+	}
+
+	type NonString struct {
+		Count int `json:"count" transform:"trim"`
+	}
+	if _, err := model.ParseInto[NonString]([]byte(`{"count":1}`)); err == nil || !strings.Contains(err.Error(), "only to string fields") {
+		t.Errorf("ParseInto() error = %v, want non-string field error", err)
+	}
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// Status is an enum with a custom UnmarshalJSON that accepts names only
+type Status int
+
+const (
+	StatusActive Status = iota + 1
+	StatusDisabled
+)
+
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	switch name {
+	case "active":
+		*s = StatusActive
+	case "disabled":
+		*s = StatusDisabled
+	default:
+		return fmt.Errorf("unknown status %q", name)
+	}
+	return nil
+}
+
+type UnmarshalItem struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity" validate:"min=1"`
+}
+
+type UnmarshalOrder struct {
+	ID       int             `json:"id" validate:"required,min=1"`
+	Paid     bool            `json:"paid"`
+	Status   Status          `json:"status"`
+	Metadata json.RawMessage `json:"metadata"`
+	PlacedAt time.Time       `json:"placed_at"`
+	Items    []UnmarshalItem `json:"items"`
+	Shipping *struct {
+		Weight float64 `json:"weight"`
+	} `json:"shipping"`
+}
+
+func TestUnmarshal_StrictInputMatchesStdlib(t *testing.T) {
+	input := []byte(`{"id":1,"paid":true,"status":"active","metadata":{"a":[1,2]},"items":[{"sku":"A","quantity":2}]}`)
+
+	var got, want UnmarshalOrder
+	if err := model.Unmarshal(input, &got); err != nil {
+		t.Fatalf("Unmarshal() unexpected error = %v", err)
+	}
+	if err := json.Unmarshal(input, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.ID != want.ID || got.Status != want.Status || string(got.Metadata) != string(want.Metadata) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshal_CoercesMismatchedFields(t *testing.T) {
+	input := []byte(`{
+		"id": "42",
+		"paid": "yes",
+		"status": "disabled",
+		"metadata": {"raw": true},
+		"placed_at": 1700000000,
+		"items": [{"sku": "A", "quantity": "3"}, {"sku": "B", "quantity": 1}],
+		"shipping": {"weight": "2.5"}
+	}`)
+
+	var order UnmarshalOrder
+	if err := model.Unmarshal(input, &order); err != nil {
+		t.Fatalf("Unmarshal() unexpected error = %v", err)
+	}
+
+	if order.ID != 42 || !order.Paid {
+		t.Errorf("coerced scalars = (%d, %v), want (42, true)", order.ID, order.Paid)
+	}
+	if order.Status != StatusDisabled {
+		t.Errorf("Status = %v, want StatusDisabled (custom UnmarshalJSON)", order.Status)
+	}
+	if string(order.Metadata) != `{"raw": true}` {
+		t.Errorf("Metadata = %s, want raw bytes preserved", order.Metadata)
+	}
+	if order.PlacedAt.Unix() != 1700000000 {
+		t.Errorf("PlacedAt = %v, want Unix 1700000000", order.PlacedAt)
+	}
+	if len(order.Items) != 2 || order.Items[0].Quantity != 3 || order.Items[1].SKU != "B" {
+		t.Errorf("Items = %+v", order.Items)
+	}
+	if order.Shipping == nil || order.Shipping.Weight != 2.5 {
+		t.Errorf("Shipping = %+v, want weight 2.5", order.Shipping)
+	}
+}
+
+func TestUnmarshal_DoesNotValidate(t *testing.T) {
+	var order UnmarshalOrder
+	if err := model.Unmarshal([]byte(`{"id":"0","items":[{"quantity":"0"}]}`), &order); err != nil {
+		t.Fatalf("Unmarshal() should not validate, got %v", err)
+	}
+
+	if err := model.Validate(&order); err == nil {
+		t.Error("Validate() expected error for id=0")
+	}
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	t.Run("custom unmarshaler errors are not coerced", func(t *testing.T) {
+		var order UnmarshalOrder
+		err := model.Unmarshal([]byte(`{"id":"1","status":"bogus"}`), &order)
+		if err == nil || !strings.Contains(err.Error(), "unknown status") {
+			t.Errorf("expected custom unmarshaler error, got %v", err)
+		}
+	})
+
+	t.Run("custom unmarshaler type mismatch is reported", func(t *testing.T) {
+		var order UnmarshalOrder
+		err := model.Unmarshal([]byte(`{"id":"1","status":5}`), &order)
+
+		var errs model.ErrorList
+		if !errors.As(err, &errs) || len(errs) != 1 {
+			t.Fatalf("expected single-error ErrorList, got %v", err)
+		}
+		if parseErr, ok := errs[0].(*model.ParseError); !ok || parseErr.Field != "Status" {
+			t.Errorf("expected ParseError on Status, got %v", errs[0])
+		}
+	})
+
+	t.Run("uncoercible value", func(t *testing.T) {
+		var order UnmarshalOrder
+		err := model.Unmarshal([]byte(`{"id":"abc"}`), &order)
+		if err == nil || !strings.Contains(err.Error(), "ID") {
+			t.Errorf("expected coercion error on ID, got %v", err)
+		}
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		var order UnmarshalOrder
+		var syntaxErr *json.SyntaxError
+		if err := model.Unmarshal([]byte(`{"id":`), &order); !errors.As(err, &syntaxErr) {
+			t.Errorf("expected *json.SyntaxError, got %T: %v", err, err)
+		}
+	})
+
+	t.Run("non-pointer target", func(t *testing.T) {
+		if err := model.Unmarshal([]byte(`{}`), UnmarshalOrder{}); err == nil {
+			t.Error("expected error for non-pointer target")
+		}
+	})
+}
+
+func TestUnmarshal_CaseInsensitiveKeysWithCoercion(t *testing.T) {
+	type account struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	// "age" needs coercion, so fields are decoded one by one; the other keys differ
+	// from their json tags only in case and must still match, as with json.Unmarshal
+	var got account
+	if err := model.Unmarshal([]byte(`{"ID":"42","Name":"bob","age":"7"}`), &got); err != nil {
+		t.Fatalf("Unmarshal() unexpected error = %v", err)
+	}
+	if got.ID != 42 || got.Name != "bob" || got.Age != 7 {
+		t.Errorf("Unmarshal() = %+v, want {ID:42 Name:bob Age:7}", got)
+	}
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Money stores an amount in cents and decodes from "12.34"-style strings
+type Money int64
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("money must be a string: %w", err)
+	}
+	var whole, cents int64
+	if _, err := fmt.Sscanf(s, "%d.%02d", &whole, &cents); err != nil {
+		return fmt.Errorf("invalid money %q", s)
+	}
+	*m = Money(whole*100 + cents)
+	return nil
+}
+
+// Level decodes from YAML names only
+type Level int
+
+func (l *Level) UnmarshalYAML(node *yaml.Node) error {
+	switch strings.ToLower(node.Value) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return fmt.Errorf("unknown level %q", node.Value)
+	}
+	return nil
+}
+
+type Invoice struct {
+	// ID is sent as a string in every input below, forcing the coercion path
+	ID       int             `json:"id" yaml:"id"`
+	Total    Money           `json:"total" yaml:"total" validate:"min=1"`
+	Discount *Money          `json:"discount" yaml:"discount"`
+	Lines    []Money         `json:"lines" yaml:"lines"`
+	Status   Status          `json:"status" yaml:"status"`
+	Extra    json.RawMessage `json:"extra" yaml:"extra"`
+}
+
+func TestParseInto_JSONUnmarshalerDuringCoercion(t *testing.T) {
+	input := `{"id":"7","total":"12.34","discount":"0.50","lines":["1.00","2.05"],"status":"disabled","extra":{"a":1}}`
+
+	invoice, err := model.ParseInto[Invoice]([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseInto() unexpected error = %v", err)
+	}
+
+	if invoice.ID != 7 {
+		t.Errorf("ID = %d, want 7", invoice.ID)
+	}
+	if invoice.Total != 1234 {
+		t.Errorf("Total = %d, want 1234", invoice.Total)
+	}
+	if invoice.Discount == nil || *invoice.Discount != 50 {
+		t.Errorf("Discount = %v, want 50", invoice.Discount)
+	}
+	if len(invoice.Lines) != 2 || invoice.Lines[1] != 205 {
+		t.Errorf("Lines = %v, want [100 205]", invoice.Lines)
+	}
+	if invoice.Status != StatusDisabled {
+		t.Errorf("Status = %v, want StatusDisabled", invoice.Status)
+	}
+	if string(invoice.Extra) != `{"a":1}` {
+		t.Errorf("Extra = %s, want {\"a\":1}", invoice.Extra)
+	}
+}
+
+func TestParseInto_JSONUnmarshalerErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"unmarshaler rejects value", `{"id":"7","total":"abc"}`, "invalid money"},
+		{"unmarshaler rejects type", `{"id":"7","total":12}`, "money must be a string"},
+		{"validation runs on decoded value", `{"id":"7","total":"0.00"}`, "at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Invoice]([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseInto() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInto_YAMLUnmarshalerDuringCoercion(t *testing.T) {
+	type Alert struct {
+		ID    int   `yaml:"id"`
+		Level Level `yaml:"level"`
+	}
+
+	alert, err := model.ParseIntoWithFormat[Alert]([]byte("id: \"3\"\nlevel: HIGH\n"), model.FormatYAML)
+	if err != nil {
+		t.Fatalf("ParseIntoWithFormat() unexpected error = %v", err)
+	}
+	if alert.ID != 3 || alert.Level != 2 {
+		t.Errorf("got %+v, want {ID:3 Level:2}", alert)
+	}
+
+	if _, err := model.ParseIntoWithFormat[Alert]([]byte("id: \"3\"\nlevel: extreme\n"), model.FormatYAML); err == nil {
+		t.Error("expected error from UnmarshalYAML")
+	}
+}
+
+func TestCoerceValue_JSONUnmarshaler(t *testing.T) {
+	result, err := model.CoerceValue("3.25", reflect.TypeOf(Money(0)), "amount")
+	if err != nil {
+		t.Fatalf("CoerceValue() unexpected error = %v", err)
+	}
+	if result != Money(325) {
+		t.Errorf("CoerceValue() = %v (%T), want Money(325)", result, result)
+	}
+}
+
+// Timeout is a duration-like type that decodes from text such as "1m30s"
+type Timeout struct {
+	time.Duration
+}
+
+func (t *Timeout) UnmarshalText(text []byte) error {
+	d, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	t.Duration = d
+	return nil
+}
+
+func TestParseInto_TextUnmarshaler(t *testing.T) {
+	type Endpoint struct {
+		Port    int      `json:"port" yaml:"port"`
+		Address net.IP   `json:"address" yaml:"address" validate:"required"`
+		Allow   []net.IP `json:"allow" yaml:"allow"`
+		Timeout Timeout  `json:"timeout" yaml:"timeout"`
+		Retry   *Timeout `json:"retry" yaml:"retry"`
+	}
+
+	inputs := map[string]string{
+		"json": `{"port":"8080","address":"10.0.0.1","allow":["::1","192.168.1.1"],"timeout":"1m30s","retry":"500ms"}`,
+		"yaml": "port: \"8080\"\naddress: 10.0.0.1\nallow: [\"::1\", 192.168.1.1]\ntimeout: 1m30s\nretry: 500ms\n",
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			endpoint, err := model.ParseInto[Endpoint]([]byte(input))
+			if err != nil {
+				t.Fatalf("ParseInto() unexpected error = %v", err)
+			}
+
+			if endpoint.Port != 8080 {
+				t.Errorf("Port = %d, want 8080", endpoint.Port)
+			}
+			if !endpoint.Address.Equal(net.ParseIP("10.0.0.1")) {
+				t.Errorf("Address = %v, want 10.0.0.1", endpoint.Address)
+			}
+			if len(endpoint.Allow) != 2 || !endpoint.Allow[0].Equal(net.IPv6loopback) {
+				t.Errorf("Allow = %v, want [::1 192.168.1.1]", endpoint.Allow)
+			}
+			if endpoint.Timeout.Duration != 90*time.Second {
+				t.Errorf("Timeout = %v, want 1m30s", endpoint.Timeout.Duration)
+			}
+			if endpoint.Retry == nil || endpoint.Retry.Duration != 500*time.Millisecond {
+				t.Errorf("Retry = %v, want 500ms", endpoint.Retry)
+			}
+		})
+	}
+}
+
+func TestParseInto_TextUnmarshalerErrors(t *testing.T) {
+	type Endpoint struct {
+		Port    int     `json:"port"`
+		Address net.IP  `json:"address"`
+		Timeout Timeout `json:"timeout"`
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"invalid ip", `{"port":"1","address":"not-an-ip"}`, "Address"},
+		{"invalid duration", `{"port":"1","timeout":"soon"}`, "Timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Endpoint]([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.field) {
+				t.Errorf("ParseInto() error = %v, want error on %s", err, tt.field)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package tests
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/vnykmshr/gopantic/pkg/model"
 )
@@ -163,3 +166,697 @@ func TestValidation_LengthValidator(t *testing.T) {
 		})
 	}
 }
+
+// RegexRecord exercises the built-in regex validator with plain and quoted patterns
+type RegexRecord struct {
+	Code    string  `json:"code" validate:"regex=^[A-Z]{3}-\\d+$"`
+	Slug    string  `json:"slug" validate:"required,regex='^[a-z]{2,8}$',max=8"`
+	Comment *string `json:"comment" validate:"regex='^it''s .+$'"`
+}
+
+func TestValidation_RegexValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"valid", `{"code":"ABC-123","slug":"widget"}`, ""},
+		{"empty code skipped", `{"slug":"widget"}`, ""},
+		{"code mismatch", `{"code":"abc-123","slug":"widget"}`, "must match pattern"},
+		{"quoted pattern with comma", `{"code":"ABC-1","slug":"a"}`, "must match pattern ^[a-z]{2,8}$"},
+		{"escaped quote in pattern", `{"slug":"widget","comment":"it's fine"}`, ""},
+		{"escaped quote mismatch", `{"slug":"widget","comment":"its fine"}`, "must match pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[RegexRecord]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidation_RegexRuleName(t *testing.T) {
+	record := RegexRecord{Code: "bad", Slug: "widget"}
+	err := model.Validate(&record)
+
+	var errs model.ErrorList
+	if !errors.As(err, &errs) || len(errs.ValidationErrors()) != 1 {
+		t.Fatalf("expected one validation error, got %v", err)
+	}
+	if rule := errs.ValidationErrors()[0].Rule; rule != "regex" {
+		t.Errorf("Rule = %q, want regex", rule)
+	}
+}
+
+func TestValidation_RegexInvalidPattern(t *testing.T) {
+	type BadPattern struct {
+		Value string `json:"value" validate:"regex=^[a-z"`
+	}
+
+	_, err := model.ParseInto[BadPattern]([]byte(`{"value":"abc"}`))
+	if err == nil || !strings.Contains(err.Error(), "invalid regex pattern") {
+		t.Errorf("expected invalid pattern error, got %v", err)
+	}
+}
+
+// UnicodeName exercises the Unicode-aware alpha validators alongside the ASCII ones
+type UnicodeName struct {
+	First    string  `json:"first" validate:"alphaunicode"`
+	Handle   string  `json:"handle" validate:"alphanumunicode"`
+	Nickname *string `json:"nickname" validate:"alphaunicode"`
+	ASCII    string  `json:"ascii" validate:"alpha"`
+}
+
+func TestValidation_UnicodeAlpha(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"accented latin", `{"first":"José","handle":"José99"}`, ""},
+		{"decomposed accent", `{"first":"Jose\u0301"}`, ""},
+		{"cyrillic", `{"first":"Анна","handle":"Анна2024"}`, ""},
+		{"greek", `{"first":"Ελένη"}`, ""},
+		{"cjk", `{"first":"山田","handle":"山田１２"}`, ""},
+		{"devanagari", `{"first":"नमस्ते","handle":"अनु४२"}`, ""},
+		{"arabic digits", `{"handle":"علي٣"}`, ""},
+		{"pointer value", `{"nickname":"Zoë"}`, ""},
+		{"empty skipped", `{"first":""}`, ""},
+		{"space rejected", `{"first":"Mary Ann"}`, "only letters"},
+		{"digit rejected", `{"first":"José2"}`, "only letters"},
+		{"punctuation rejected", `{"handle":"josé_99"}`, "only letters and digits"},
+		{"ascii alpha unchanged", `{"ascii":"José"}`, "only alphabetic"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[UnicodeName]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// AccountRecord keeps numeric-looking values as strings
+type AccountRecord struct {
+	Number  string  `json:"number" validate:"required,number,length=5"`
+	Balance string  `json:"balance" validate:"numeric"`
+	Ref     *string `json:"ref" validate:"number"`
+}
+
+func TestValidation_NumericStrings(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"zero padded number", `{"number":"00042","balance":"-12.50"}`, ""},
+		{"signed integer numeric", `{"number":"00042","balance":"+7"}`, ""},
+		{"pointer number", `{"number":"00042","ref":"0099"}`, ""},
+		{"empty numeric skipped", `{"number":"00042","balance":""}`, ""},
+		{"number with sign", `{"number":"-0042"}`, "only digits"},
+		{"number with decimal", `{"number":"00.42"}`, "only digits"},
+		{"numeric with letters", `{"number":"00042","balance":"12a"}`, "numeric string"},
+		{"numeric trailing dot", `{"number":"00042","balance":"12."}`, "numeric string"},
+		{"numeric exponent", `{"number":"00042","balance":"1e5"}`, "numeric string"},
+		{"pointer number invalid", `{"number":"00042","ref":"x1"}`, "only digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := model.ParseInto[AccountRecord]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ParseInto() unexpected error = %v", err)
+				}
+				if account.Number != "00042" {
+					t.Errorf("Number = %q, leading zeros should be preserved", account.Number)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidation_NumericRejectsNonString(t *testing.T) {
+	type Wrong struct {
+		Count int `json:"count" validate:"number"`
+	}
+	if err := model.Validate(&Wrong{Count: 5}); err == nil || !strings.Contains(err.Error(), "must be a string") {
+		t.Errorf("Validate() error = %v, want non-string error", err)
+	}
+}
+
+// CollectionRecord exercises length rules on collections
+type CollectionRecord struct {
+	Tags    []string          `json:"tags" validate:"len=3"`
+	Labels  map[string]string `json:"labels" validate:"min=1,max=2"`
+	Matrix  [2]int            `json:"matrix" validate:"len=2"`
+	Code    string            `json:"code" validate:"len=4"`
+	Options map[string]int    `json:"options" validate:"length=1"`
+}
+
+func TestValidation_CollectionLength(t *testing.T) {
+	valid := func() CollectionRecord {
+		return CollectionRecord{
+			Tags:    []string{"a", "b", "c"},
+			Labels:  map[string]string{"env": "prod"},
+			Code:    "AB12",
+			Options: map[string]int{"retries": 3},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *CollectionRecord)
+		field   string
+		rule    string
+		message string
+	}{
+		{"valid", func(r *CollectionRecord) {}, "", "", ""},
+		{"too few tags", func(r *CollectionRecord) { r.Tags = r.Tags[:2] }, "Tags", "len", "exactly 3"},
+		{"too many tags", func(r *CollectionRecord) { r.Tags = append(r.Tags, "d") }, "Tags", "len", "exactly 3"},
+		{"empty map below min", func(r *CollectionRecord) { r.Labels = map[string]string{} }, "Labels", "min", "map size must be at least 1"},
+		{"map above max", func(r *CollectionRecord) { r.Labels["a"], r.Labels["b"] = "1", "2" }, "Labels", "max", "map size must be at most 2"},
+		{"string len", func(r *CollectionRecord) { r.Code = "ABC" }, "Code", "len", "exactly 4"},
+		{"map length", func(r *CollectionRecord) { r.Options = nil }, "Options", "length", "exactly 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := valid()
+			tt.modify(&record)
+			err := model.Validate(&record)
+
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var errs model.ErrorList
+			if !errors.As(err, &errs) || len(errs.ValidationErrors()) != 1 {
+				t.Fatalf("expected one validation error, got %v", err)
+			}
+			got := errs.ValidationErrors()[0]
+			if got.Field != tt.field || got.Rule != tt.rule || !strings.Contains(got.Message, tt.message) {
+				t.Errorf("error = %s/%s %q, want %s/%s containing %q", got.Field, got.Rule, got.Message, tt.field, tt.rule, tt.message)
+			}
+		})
+	}
+}
+
+func TestValidation_RequiredCollections(t *testing.T) {
+	type CORSConfig struct {
+		AllowedOrigins []string          `json:"allowed_origins" validate:"required"`
+		Headers        map[string]string `json:"headers" validate:"required"`
+		Methods        *[]string         `json:"methods" validate:"required"`
+	}
+
+	methods := []string{}
+	valid := CORSConfig{
+		AllowedOrigins: []string{"https://example.com"},
+		Headers:        map[string]string{"X-Trace": "1"},
+		Methods:        &methods, // a non-nil pointer satisfies required even when empty
+	}
+	if err := model.Validate(valid); err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *CORSConfig)
+		field  string
+	}{
+		{"nil slice", func(c *CORSConfig) { c.AllowedOrigins = nil }, "AllowedOrigins"},
+		{"empty slice", func(c *CORSConfig) { c.AllowedOrigins = []string{} }, "AllowedOrigins"},
+		{"nil map", func(c *CORSConfig) { c.Headers = nil }, "Headers"},
+		{"empty map", func(c *CORSConfig) { c.Headers = map[string]string{} }, "Headers"},
+		{"nil pointer", func(c *CORSConfig) { c.Methods = nil }, "Methods"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+
+			var validationErr *model.ValidationError
+			if err := model.Validate(config); !errors.As(err, &validationErr) ||
+				validationErr.Field != tt.field || validationErr.Rule != "required" {
+				t.Errorf("Validate() = %v, want required error on %s", err, tt.field)
+			}
+		})
+	}
+
+	t.Run("parsed input", func(t *testing.T) {
+		for _, input := range []string{`{"headers":{"a":"b"},"methods":[]}`, `{"allowed_origins":null,"headers":{"a":"b"},"methods":[]}`, `{"allowed_origins":[],"headers":{"a":"b"},"methods":[]}`} {
+			if _, err := model.ParseInto[CORSConfig]([]byte(input)); err == nil || !strings.Contains(err.Error(), "AllowedOrigins") {
+				t.Errorf("ParseInto(%s) = %v, want required error on AllowedOrigins", input, err)
+			}
+		}
+	})
+}
+
+func TestValidation_LenFromParse(t *testing.T) {
+	if _, err := model.ParseInto[CollectionRecord]([]byte(`{"tags":["a","b","c"],"labels":{"k":"v"},"code":"AB12","options":{"x":1}}`)); err != nil {
+		t.Errorf("ParseInto() unexpected error = %v", err)
+	}
+	if _, err := model.ParseInto[CollectionRecord]([]byte(`{"tags":["a"],"labels":{"k":"v"},"code":"AB12","options":{"x":1}}`)); err == nil {
+		t.Error("ParseInto() expected len error on tags")
+	}
+}
+
+func TestValidation_StringLengthCountsRunes(t *testing.T) {
+	type Profile struct {
+		Nickname string `json:"nickname" validate:"min=3,max=5"`
+		Initials string `json:"initials" validate:"length=2"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"three emoji meet min", `{"nickname":"🙂🙂🙂","initials":"ÅÖ"}`, ""},
+		{"five cjk meet max", `{"nickname":"東京都渋谷","initials":"東京"}`, ""},
+		{"accented latin", `{"nickname":"Zoë","initials":"Zö"}`, ""},
+		{"two emoji below min", `{"nickname":"🙂🙂","initials":"AB"}`, "at least 3 characters"},
+		{"seven cyrillic above max", `{"nickname":"Наталья","initials":"AB"}`, "at most 5 characters"},
+		{"length counts runes", `{"nickname":"abc","initials":"Ö"}`, "exactly 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Profile]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// LinkRecord exercises the substring validators
+type LinkRecord struct {
+	Email   string  `json:"email" validate:"contains=@"`
+	URL     string  `json:"url" validate:"startswith=https://,endswith=.com"`
+	Version *string `json:"version" validate:"startswith=1.50"`
+	List    string  `json:"list" validate:"contains=', '"`
+}
+
+func TestValidation_SubstringValidators(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		rule    string
+		message string
+	}{
+		{"valid", `{"email":"a@b","url":"https://example.com","version":"1.50.2","list":"a, b"}`, "", ""},
+		{"empty skipped", `{"email":"","url":""}`, "", ""},
+		{"missing substring", `{"email":"ab"}`, "contains", `value must contain "@"`},
+		{"wrong prefix", `{"url":"http://example.com"}`, "startswith", `value must start with "https://"`},
+		{"wrong suffix", `{"url":"https://example.org"}`, "endswith", `value must end with ".com"`},
+		{"numeric-looking parameter kept literal", `{"version":"1.5.0"}`, "startswith", `value must start with "1.50"`},
+		{"quoted parameter with comma", `{"list":"a;b"}`, "contains", `value must contain ", "`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[LinkRecord]([]byte(tt.input))
+			if tt.rule == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var errs model.ErrorList
+			if !errors.As(err, &errs) || len(errs.ValidationErrors()) != 1 {
+				t.Fatalf("expected one validation error, got %v", err)
+			}
+			got := errs.ValidationErrors()[0]
+			if got.Rule != tt.rule || got.Message != tt.message {
+				t.Errorf("error = %s %q, want %s %q", got.Rule, got.Message, tt.rule, tt.message)
+			}
+			if got.Details["substring"] == nil {
+				t.Errorf("Details = %v, want substring", got.Details)
+			}
+		})
+	}
+}
+
+// NetworkConfig exercises the IP and CIDR validators
+type NetworkConfig struct {
+	Host    string  `json:"host" validate:"ip"`
+	V4      string  `json:"v4" validate:"ipv4"`
+	V6      string  `json:"v6" validate:"ipv6"`
+	Subnet  string  `json:"subnet" validate:"cidr"`
+	Gateway *string `json:"gateway" validate:"ipv4"`
+}
+
+func TestValidation_NetworkValidators(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		rule    string
+		message string
+	}{
+		{"valid", `{"host":"::1","v4":"192.168.1.10","v6":"2001:db8::1","subnet":"10.0.0.0/8","gateway":"10.0.0.1"}`, "", ""},
+		{"ip accepts v4", `{"host":"127.0.0.1","subnet":"2001:db8::/32"}`, "", ""},
+		{"empty skipped", `{"host":"","v4":"","v6":"","subnet":""}`, "", ""},
+		{"hostname is not ip", `{"host":"localhost"}`, "ip", "value must be a valid IP address"},
+		{"octet out of range", `{"v4":"256.1.1.1"}`, "ipv4", "value must be a valid IP address"},
+		{"v6 rejected as v4", `{"v4":"::1"}`, "ipv4", "value must be a valid IPv4 address"},
+		{"mapped v4 rejected as v4", `{"v4":"::ffff:10.0.0.1"}`, "ipv4", "value must be a valid IPv4 address"},
+		{"v4 rejected as v6", `{"v6":"10.0.0.1"}`, "ipv6", "value must be a valid IPv6 address"},
+		{"missing prefix", `{"subnet":"10.0.0.0"}`, "cidr", "value must be valid CIDR notation (e.g. 10.0.0.0/8)"},
+		{"prefix too long", `{"subnet":"10.0.0.0/33"}`, "cidr", "value must be valid CIDR notation (e.g. 10.0.0.0/8)"},
+		{"pointer field", `{"gateway":"gateway.local"}`, "ipv4", "value must be a valid IP address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[NetworkConfig]([]byte(tt.input))
+			if tt.rule == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var errs model.ErrorList
+			if !errors.As(err, &errs) || len(errs.ValidationErrors()) != 1 {
+				t.Fatalf("expected one validation error, got %v", err)
+			}
+			got := errs.ValidationErrors()[0]
+			if got.Rule != tt.rule || got.Message != tt.message {
+				t.Errorf("error = %s %q, want %s %q", got.Rule, got.Message, tt.rule, tt.message)
+			}
+		})
+	}
+}
+
+func TestValidation_PortValidator(t *testing.T) {
+	type ServerConfig struct {
+		Port      int    `json:"port" validate:"port"`
+		AdminPort string `json:"admin_port" validate:"port"`
+		Metrics   *uint  `json:"metrics" validate:"port"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"valid", `{"port":8080,"admin_port":"9090","metrics":9100}`, ""},
+		{"coerced string into int", `{"port":"443"}`, ""},
+		{"bounds", `{"port":1,"admin_port":"65535","metrics":65535}`, ""},
+		{"zero rejected", `{"port":0}`, "between 1 and 65535"},
+		{"missing int port rejected", `{}`, "between 1 and 65535"},
+		{"above range", `{"port":65536}`, "between 1 and 65535"},
+		{"negative", `{"port":-1}`, "between 1 and 65535"},
+		{"string out of range", `{"port":80,"admin_port":"70000"}`, "between 1 and 65535"},
+		{"string not a number", `{"port":80,"admin_port":"http"}`, "must be a port number"},
+		{"uint out of range", `{"port":80,"metrics":100000}`, "between 1 and 65535"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[ServerConfig]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidation_TimeRelativeValidators(t *testing.T) {
+	type Event struct {
+		StartsAt  time.Time  `json:"starts_at" validate:"future"`
+		CreatedAt *time.Time `json:"created_at" validate:"past"`
+		SentAt    time.Time  `json:"sent_at" validate:"future=5m"`
+		SeenAt    time.Time  `json:"seen_at" validate:"past=300"`
+	}
+
+	stamp := func(d time.Duration) string {
+		return time.Now().Add(d).Format(time.RFC3339)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"valid", `{"starts_at":"` + stamp(time.Hour) + `","created_at":"` + stamp(-time.Hour) + `"}`, ""},
+		{"zero and nil skipped", `{}`, ""},
+		{"start in past", `{"starts_at":"` + stamp(-time.Hour) + `"}`, "time must be in the future"},
+		{"created in future", `{"created_at":"` + stamp(time.Hour) + `"}`, "time must be in the past"},
+		{"within future tolerance", `{"sent_at":"` + stamp(-2*time.Minute) + `"}`, ""},
+		{"beyond future tolerance", `{"sent_at":"` + stamp(-10*time.Minute) + `"}`, "time must be in the future"},
+		{"within past tolerance (seconds)", `{"seen_at":"` + stamp(2*time.Minute) + `"}`, ""},
+		{"beyond past tolerance (seconds)", `{"seen_at":"` + stamp(10*time.Minute) + `"}`, "time must be in the past"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Event]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidation_TimeRelativeFrozenClock(t *testing.T) {
+	type Event struct {
+		StartsAt time.Time `json:"starts_at" validate:"future"`
+		EndsAt   time.Time `json:"ends_at" validate:"past=1m"`
+	}
+
+	fixed := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	model.Now = func() time.Time { return fixed }
+	defer func() { model.Now = time.Now }()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"one second after", `{"starts_at":"2030-06-01T12:00:01Z"}`, ""},
+		{"exactly now is not future", `{"starts_at":"2030-06-01T12:00:00Z"}`, "time must be in the future"},
+		{"inside past tolerance", `{"ends_at":"2030-06-01T12:00:59Z"}`, ""},
+		{"at past tolerance edge", `{"ends_at":"2030-06-01T12:01:00Z"}`, "time must be in the past"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Event]([]byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseInto() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseInto() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidation_TimeRelativeRejectsNonTime(t *testing.T) {
+	type Event struct {
+		StartsAt string `json:"starts_at" validate:"future"`
+	}
+
+	_, err := model.ParseInto[Event]([]byte(`{"starts_at":"tomorrow"}`))
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("ParseInto() error = %v, want unsupported type error", err)
+	}
+}
+func TestValidateDetailed(t *testing.T) {
+	valid := ValidatedUser{ID: 1, Username: "alice", Email: "alice@example.com", Age: 30, Name: "Alice"}
+	if errs := model.ValidateDetailed(&valid); errs != nil {
+		t.Errorf("ValidateDetailed() = %v, want nil for valid struct", errs)
+	}
+	if errs := model.ValidateDetailed(valid); errs != nil {
+		t.Errorf("ValidateDetailed() = %v, want nil for valid struct value", errs)
+	}
+
+	invalid := ValidatedUser{ID: 0, Username: "al", Email: "nope", Age: 30, Name: "Alice"}
+	errs := model.ValidateDetailed(&invalid)
+	if errs == nil {
+		t.Fatal("ValidateDetailed() = nil, want errors")
+	}
+
+	byField := errs.GroupByField()
+	for _, field := range []string{"ID", "Username", "Email"} {
+		if len(byField[field]) == 0 {
+			t.Errorf("GroupByField() missing errors for %s: %v", field, errs)
+		}
+	}
+	if report := errs.ToStructuredReport(); report == nil || report.Count != len(byField) {
+		t.Errorf("ToStructuredReport() = %+v, want %d fields", report, len(byField))
+	}
+
+	// Single failures are returned as a one-entry list too
+	single := ValidatedUser{ID: 1, Username: "alice", Email: "alice@example.com", Age: 30, Name: "A"}
+	if errs := model.ValidateDetailed(&single); errs == nil || len(*errs) != 1 {
+		t.Errorf("ValidateDetailed() = %v, want exactly one error", errs)
+	}
+}
+
+func TestValidateDetailed_InvalidArguments(t *testing.T) {
+	var nilUser *ValidatedUser
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"nil pointer", nilUser},
+		{"non-struct", 42},
+		{"nil interface", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := model.ValidateDetailed(tt.input)
+			if errs == nil || len(*errs) != 1 || !strings.Contains(errs.Error(), "ValidateDetailed") {
+				t.Errorf("ValidateDetailed(%v) = %v, want single argument error", tt.input, errs)
+			}
+		})
+	}
+}
+
+func TestValidate_StructValue(t *testing.T) {
+	valid := ValidatedUser{ID: 1, Username: "alice", Email: "alice@example.com", Age: 30, Name: "Alice"}
+	if err := model.Validate(valid); err != nil {
+		t.Errorf("Validate(value) = %v, want nil", err)
+	}
+
+	invalid := ValidatedUser{ID: 1, Username: "alice", Email: "nope", Age: 30, Name: "Alice"}
+	var validationErr *model.ValidationError
+	if err := model.Validate(invalid); !errors.As(err, &validationErr) || validationErr.Field != "Email" {
+		t.Errorf("Validate(value) = %v, want Email error", err)
+	}
+
+	// Pointer-receiver self-validation runs on a copy of the value
+	start := time.Now()
+	booking := StayBooking{Start: start, End: start.Add(-time.Hour), Guests: 1}
+	if err := model.Validate(booking); err == nil || !strings.Contains(err.Error(), "end must be after start") {
+		t.Errorf("Validate(value) = %v, want self-validation error", err)
+	}
+
+	if err := model.ValidateWithContext(context.Background(), invalid); err == nil {
+		t.Error("ValidateWithContext(value) = nil, want error")
+	}
+	if err := model.ValidateGroups(invalid); err == nil {
+		t.Error("ValidateGroups(value) = nil, want error")
+	}
+}
+
+func TestValidate_InvalidArguments(t *testing.T) {
+	var nilUser *ValidatedUser
+	if err := model.Validate(nilUser); err == nil || !strings.Contains(err.Error(), "nil pointer") {
+		t.Errorf("Validate(nil pointer) = %v, want nil pointer error", err)
+	}
+	if err := model.Validate(42); err == nil || !strings.Contains(err.Error(), "expected struct") {
+		t.Errorf("Validate(42) = %v, want expected struct error", err)
+	}
+}
+
+func TestValidate_Collections(t *testing.T) {
+	valid := ValidatedUser{ID: 1, Username: "alice", Email: "alice@example.com", Age: 30, Name: "Alice"}
+	badEmail := valid
+	badEmail.Email = "nope"
+	badAge := valid
+	badAge.Age = 12
+
+	paths := func(err error) []string {
+		var list model.ErrorList
+		if !errors.As(err, &list) {
+			return nil
+		}
+		var out []string
+		for _, ve := range list.ValidationErrors() {
+			out = append(out, ve.FieldPath)
+		}
+		return out
+	}
+
+	t.Run("slice", func(t *testing.T) {
+		users := []ValidatedUser{valid, badEmail, badAge}
+		err := model.Validate(&users)
+		if got := paths(err); strings.Join(got, ",") != "[1].Email,[2].Age" {
+			t.Errorf("paths = %v, want [1].Email and [2].Age (err: %v)", got, err)
+		}
+		if err := model.Validate(users[:1]); err != nil {
+			t.Errorf("Validate(valid slice) = %v, want nil", err)
+		}
+	})
+
+	t.Run("slice of pointers", func(t *testing.T) {
+		users := []*ValidatedUser{&valid, nil, &badEmail}
+		var validationErr *model.ValidationError
+		if err := model.Validate(users); !errors.As(err, &validationErr) || validationErr.FieldPath != "[2].Email" {
+			t.Errorf("Validate() = %v, want [2].Email error", err)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		users := map[string]ValidatedUser{"alice": valid, "bob": badEmail, "carol": badAge}
+		err := model.Validate(users)
+		if got := paths(err); strings.Join(got, ",") != "[bob].Email,[carol].Age" {
+			t.Errorf("paths = %v, want [bob].Email and [carol].Age (err: %v)", got, err)
+		}
+	})
+
+	t.Run("detailed", func(t *testing.T) {
+		errs := model.ValidateDetailed([]ValidatedUser{badEmail})
+		if errs == nil || len(*errs) != 1 || errs.ValidationErrors()[0].FieldPath != "[0].Email" {
+			t.Errorf("ValidateDetailed() = %v, want one [0].Email error", errs)
+		}
+	})
+
+	t.Run("collections without rules", func(t *testing.T) {
+		if err := model.Validate([]string{"a"}); err != nil {
+			t.Errorf("Validate([]string) = %v, want nil", err)
+		}
+	})
+}
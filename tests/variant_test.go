@@ -0,0 +1,234 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// Shape is a discriminated union of Circle and Rect
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Kind   string  `json:"kind" yaml:"kind"`
+	Radius float64 `json:"radius" yaml:"radius" validate:"min=1"`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+type Rect struct {
+	Kind   string `json:"kind" yaml:"kind"`
+	Width  int    `json:"width" yaml:"width" validate:"required"`
+	Height int    `json:"height" yaml:"height" default:"1"`
+}
+
+func (r *Rect) Area() float64 { return float64(r.Width * r.Height) }
+
+func registerShapes(t *testing.T) {
+	t.Helper()
+	err := model.RegisterVariant[Shape]("kind", map[string]reflect.Type{
+		"circle": reflect.TypeOf(Circle{}),
+		"rect":   reflect.TypeOf(&Rect{}),
+	})
+	if err != nil {
+		t.Fatalf("RegisterVariant: %v", err)
+	}
+}
+
+// TestParseVariant_SelectsType verifies the discriminator picks the concrete type
+func TestParseVariant_SelectsType(t *testing.T) {
+	registerShapes(t)
+
+	shape, err := model.ParseVariant[Shape]([]byte(`{"kind":"circle","radius":"2"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if circle, ok := shape.(Circle); !ok || circle.Radius != 2 {
+		t.Errorf("shape = %#v, want Circle with radius 2", shape)
+	}
+
+	shape, err = model.ParseVariant[Shape]([]byte("kind: rect\nwidth: 3\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rect, ok := shape.(*Rect)
+	if !ok || rect.Width != 3 || rect.Height != 1 {
+		t.Errorf("shape = %#v, want *Rect 3x1", shape)
+	}
+}
+
+// TestParseVariant_Validates verifies the selected type is validated
+func TestParseVariant_Validates(t *testing.T) {
+	registerShapes(t)
+
+	_, err := model.ParseVariant[Shape]([]byte(`{"kind":"rect","height":2}`))
+
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "Width" {
+		t.Errorf("expected required error on Width, got %v", err)
+	}
+}
+
+// TestParseVariant_Discriminator verifies missing and unknown discriminators are reported
+func TestParseVariant_Discriminator(t *testing.T) {
+	registerShapes(t)
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"missing", `{"radius":2}`, "missing discriminator"},
+		{"unknown", `{"kind":"hexagon"}`, `unknown variant "hexagon"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseVariant[Shape]([]byte(tt.input))
+
+			var parseErr *model.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected *model.ParseError, got %T: %v", err, err)
+			}
+			if parseErr.Field != "kind" {
+				t.Errorf("Field = %q, want kind", parseErr.Field)
+			}
+			if !strings.Contains(parseErr.Message, tt.want) || !strings.Contains(parseErr.Message, "circle, rect") {
+				t.Errorf("unexpected message: %s", parseErr.Message)
+			}
+		})
+	}
+}
+
+// TestRegisterVariant_Invalid verifies types that cannot satisfy the base are rejected
+func TestRegisterVariant_Invalid(t *testing.T) {
+	type Unrelated struct{}
+
+	if err := model.RegisterVariant[Shape]("kind", map[string]reflect.Type{"x": reflect.TypeOf(Unrelated{})}); err == nil {
+		t.Error("expected error for type not implementing Shape")
+	}
+	if err := model.RegisterVariant[Shape]("kind", map[string]reflect.Type{"x": reflect.TypeOf(0)}); err == nil {
+		t.Error("expected error for non-struct variant")
+	}
+	if err := model.RegisterVariant[Circle]("kind", map[string]reflect.Type{"circle": reflect.TypeOf(Circle{})}); err == nil {
+		t.Error("expected error for non-interface base")
+	}
+	if _, err := model.ParseVariant[interface{ Perimeter() float64 }]([]byte(`{}`)); err == nil {
+		t.Error("expected error for unregistered base")
+	}
+}
+
+// Drawing holds interface fields resolved through registered variants
+type Drawing struct {
+	Title  string  `json:"title" yaml:"title"`
+	Main   Shape   `json:"main" yaml:"main"`
+	Layers []Shape `json:"layers" yaml:"layers"`
+}
+
+// TestParseInto_InterfaceField verifies registered interface fields are parsed into their variants
+func TestParseInto_InterfaceField(t *testing.T) {
+	registerShapes(t)
+
+	drawing, err := model.ParseInto[Drawing]([]byte(`{
+		"title": "plan",
+		"main": {"kind": "rect", "width": "4", "height": 2},
+		"layers": [{"kind": "circle", "radius": 1}, null]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rect, ok := drawing.Main.(*Rect); !ok || rect.Width != 4 || rect.Height != 2 {
+		t.Errorf("Main = %#v, want *Rect 4x2", drawing.Main)
+	}
+	if len(drawing.Layers) != 2 || drawing.Layers[1] != nil {
+		t.Fatalf("Layers = %#v", drawing.Layers)
+	}
+	if circle, ok := drawing.Layers[0].(Circle); !ok || circle.Radius != 1 {
+		t.Errorf("Layers[0] = %#v, want Circle", drawing.Layers[0])
+	}
+
+	yamlDrawing, err := model.ParseInto[Drawing]([]byte("title: plan\nmain:\n  kind: circle\n  radius: 3\n"))
+	if err != nil {
+		t.Fatalf("unexpected YAML error: %v", err)
+	}
+	if _, ok := yamlDrawing.Main.(Circle); !ok {
+		t.Errorf("YAML Main = %#v, want Circle", yamlDrawing.Main)
+	}
+}
+
+// TestParseInto_InterfaceFieldErrors verifies variant errors carry the field path
+func TestParseInto_InterfaceFieldErrors(t *testing.T) {
+	registerShapes(t)
+
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"unknown variant", `{"main": {"kind": "star"}}`, "Main.kind"},
+		{"slice element", `{"layers": [{"kind": "circle", "radius": 2}, {"radius": 2}]}`, "Layers[1].kind"},
+		{"nested validation", `{"main": {"kind": "circle", "radius": 0}}`, "Main.Radius"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.ParseInto[Drawing]([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.field) {
+				t.Errorf("expected error on %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+// TypedResponse carries typed data selected by a discriminator tag on an interface{} field
+type TypedResponse struct {
+	Status string      `json:"status"`
+	Data   interface{} `json:"data" discriminator:"type"`
+	Raw    interface{} `json:"raw"`
+}
+
+type UserData struct {
+	Type string `json:"type"`
+	Name string `json:"name" validate:"required"`
+}
+
+type OrderData struct {
+	Type  string `json:"type"`
+	Total int    `json:"total"`
+}
+
+// TestParseInto_DiscriminatorTag verifies interface{} fields are typed only under the tag
+func TestParseInto_DiscriminatorTag(t *testing.T) {
+	err := model.RegisterVariant[interface{}]("kind", map[string]reflect.Type{
+		"user":  reflect.TypeOf(UserData{}),
+		"order": reflect.TypeOf(OrderData{}),
+	})
+	if err != nil {
+		t.Fatalf("RegisterVariant: %v", err)
+	}
+
+	resp, err := model.ParseInto[TypedResponse]([]byte(`{
+		"status": "ok",
+		"data": {"type": "order", "total": "12"},
+		"raw": {"type": "order"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order, ok := resp.Data.(OrderData); !ok || order.Total != 12 {
+		t.Errorf("Data = %#v, want OrderData with total 12", resp.Data)
+	}
+	if _, ok := resp.Raw.(map[string]interface{}); !ok {
+		t.Errorf("Raw = %#v, want untagged map", resp.Raw)
+	}
+
+	_, err = model.ParseInto[TypedResponse]([]byte(`{"data": {"type": "user"}}`))
+	if err == nil || !strings.Contains(err.Error(), `"Data.Name": field is required`) {
+		t.Errorf("expected required error on Data.Name, got %v", err)
+	}
+}
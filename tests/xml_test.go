@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+// SOAPOrder is parsed from an XML payload
+type SOAPOrder struct {
+	ID       int            `xml:"id,attr" validate:"required"`
+	Status   string         `xml:"status" validate:"required"`
+	Total    float64        `xml:"total" validate:"min=0"`
+	Paid     bool           `xml:"paid"`
+	Customer SOAPCustomer   `xml:"customer"`
+	Items    []SOAPLineItem `xml:"item"`
+	Tags     []string       `xml:"tag"`
+}
+
+// SOAPCustomer is a nested element with an attribute and text
+type SOAPCustomer struct {
+	Tier string `xml:"tier,attr"`
+	Name string `xml:",chardata" validate:"required"`
+}
+
+// SOAPLineItem is a repeated element
+type SOAPLineItem struct {
+	SKU      string `xml:"sku" validate:"required"`
+	Quantity int    `xml:"qty" validate:"min=1"`
+}
+
+const soapOrderXML = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- exported by the billing service -->
+<order id="1042" xmlns="urn:example:orders">
+	<status>shipped</status>
+	<total>99.50</total>
+	<paid>true</paid>
+	<customer tier="gold">Ada Lovelace</customer>
+	<item><sku>A-1</sku><qty>2</qty></item>
+	<item><sku>B-7</sku><qty>1</qty></item>
+	<tag>priority</tag>
+</order>`
+
+func TestParseInto_XML(t *testing.T) {
+	if got := model.DetectFormat([]byte(soapOrderXML)); got != model.FormatXML {
+		t.Fatalf("DetectFormat() = %v, want FormatXML", got)
+	}
+
+	order, err := model.ParseInto[SOAPOrder]([]byte(soapOrderXML))
+	if err != nil {
+		t.Fatalf("ParseInto() error = %v", err)
+	}
+
+	if order.ID != 1042 || order.Status != "shipped" || order.Total != 99.5 || !order.Paid {
+		t.Errorf("got %+v", order)
+	}
+	if order.Customer.Tier != "gold" || order.Customer.Name != "Ada Lovelace" {
+		t.Errorf("Customer = %+v", order.Customer)
+	}
+	if len(order.Items) != 2 || order.Items[1].SKU != "B-7" || order.Items[0].Quantity != 2 {
+		t.Errorf("Items = %+v", order.Items)
+	}
+	// A single element still fills a slice field
+	if len(order.Tags) != 1 || order.Tags[0] != "priority" {
+		t.Errorf("Tags = %q, want [priority]", order.Tags)
+	}
+}
+
+func TestParseInto_XMLValidation(t *testing.T) {
+	raw := []byte(`<order id="7">
+		<status></status>
+		<customer>Grace</customer>
+		<item><sku>A-1</sku><qty>0</qty></item>
+	</order>`)
+
+	_, err := model.ParseIntoWithFormat[SOAPOrder](raw, model.FormatXML)
+	var errs model.ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ErrorList, got %v", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+	for _, want := range []string{"Status", "Quantity"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected an error on %s, got %v", want, err)
+		}
+	}
+}
+
+func TestParseInto_XMLCoercionError(t *testing.T) {
+	_, err := model.ParseInto[SOAPOrder]([]byte(`<order id="x"><status>new</status><customer>Bo</customer></order>`))
+	var parseErr *model.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Field != "ID" {
+		t.Fatalf("expected a ParseError on ID, got %v", err)
+	}
+}
+
+func TestXMLParser_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"mismatched tags", `<order><status>new</order>`},
+		{"unclosed root", `<order><status>new</status>`},
+		{"no root element", `<?xml version="1.0"?>`},
+	}
+
+	parser := model.GetParser(model.FormatXML)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parser.Parse([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), "xml parse error") {
+				t.Errorf("Parse(%q) error = %v, want xml parse error", tt.input, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vnykmshr/gopantic/pkg/model"
+)
+
+type Manifest struct {
+	Kind     string `yaml:"kind" validate:"required"`
+	Name     string `yaml:"name" validate:"required,min=2"`
+	Replicas int    `yaml:"replicas" validate:"min=1"`
+}
+
+func TestParseAllYAML(t *testing.T) {
+	input := `# deployment manifests
+---
+kind: Deployment
+name: api
+replicas: "3"
+---
+---
+kind: Service
+name: api
+replicas: 1
+...
+`
+
+	manifests, err := model.ParseAllYAML[Manifest]([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseAllYAML() unexpected error = %v", err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("ParseAllYAML() returned %d documents, want 2", len(manifests))
+	}
+	if manifests[0].Kind != "Deployment" || manifests[0].Replicas != 3 {
+		t.Errorf("manifests[0] = %+v, want Deployment with 3 replicas", manifests[0])
+	}
+	if manifests[1].Kind != "Service" || manifests[1].Name != "api" {
+		t.Errorf("manifests[1] = %+v, want Service api", manifests[1])
+	}
+}
+
+func TestParseAllYAML_Errors(t *testing.T) {
+	input := "kind: Deployment\nname: api\nreplicas: 2\n---\nkind: Service\nname: a\nreplicas: 1\n---\nname: db\nreplicas: 0\n"
+
+	manifests, err := model.ParseAllYAML[Manifest]([]byte(input))
+	if err == nil {
+		t.Fatal("ParseAllYAML() expected error, got nil")
+	}
+	if len(manifests) != 3 || manifests[0].Name != "api" {
+		t.Fatalf("ParseAllYAML() = %+v, want 3 documents with the first parsed", manifests)
+	}
+
+	errs, ok := err.(model.ErrorList)
+	if !ok {
+		t.Fatalf("expected ErrorList, got %T", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 document errors, got %d: %v", len(errs), errs)
+	}
+	if !strings.HasPrefix(errs[0].Error(), "document 1:") || !strings.HasPrefix(errs[1].Error(), "document 2:") {
+		t.Errorf("errors not prefixed with document index: %v", errs)
+	}
+}
+
+func TestParseAllYAML_SyntaxError(t *testing.T) {
+	input := "kind: Deployment\nname: api\nreplicas: 1\n---\nkind: [unclosed\n"
+
+	manifests, err := model.ParseAllYAML[Manifest]([]byte(input))
+	if err == nil || !strings.Contains(err.Error(), "document 1: yaml parse error") {
+		t.Fatalf("ParseAllYAML() error = %v, want document 1 parse error", err)
+	}
+	if len(manifests) != 1 {
+		t.Errorf("ParseAllYAML() returned %d documents, want 1 parsed before the error", len(manifests))
+	}
+}
+
+func TestParseAllYAML_Empty(t *testing.T) {
+	manifests, err := model.ParseAllYAML[Manifest](nil)
+	if err != nil || len(manifests) != 0 {
+		t.Errorf("ParseAllYAML(nil) = %v, %v, want no documents and no error", manifests, err)
+	}
+}